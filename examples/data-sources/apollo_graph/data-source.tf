@@ -0,0 +1,16 @@
+# Look up a graph by ID...
+data "apollo_graph" "by_id" {
+  graph_id = "supergraph-prod"
+}
+
+# ...or by name within an organization.
+data "apollo_graph" "by_name" {
+  org_id     = "my-org"
+  graph_name = "Supergraph"
+}
+
+resource "apollo_apikey" "ci" {
+  graph_id = data.apollo_graph.by_name.graph_id
+  key_name = "ci"
+  role     = "CONSUMER"
+}
@@ -0,0 +1,12 @@
+data "apollo_graphs" "all" {
+  org_id = data.apollo_organization.example.id
+}
+
+# Issue a read-only key for every graph in the organization.
+resource "apollo_apikey" "ci" {
+  for_each = { for graph in data.apollo_graphs.all.graphs : graph.graph_id => graph }
+
+  graph_id = each.key
+  key_name = "ci"
+  role     = "CONSUMER"
+}
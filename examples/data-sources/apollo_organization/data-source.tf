@@ -0,0 +1,7 @@
+data "apollo_organization" "example" {
+  id = "my-org"
+}
+
+output "plan_tier" {
+  value = data.apollo_organization.example.plan_tier
+}
@@ -0,0 +1,11 @@
+data "apollo_schema_check" "products" {
+  graph_id        = apollo_graph.example.graph_id
+  variant         = "current"
+  subgraph_name   = "products"
+  sdl             = file("${path.module}/schemas/products.graphql")
+  fail_on_failure = true
+}
+
+output "products_check_url" {
+  value = data.apollo_schema_check.products.workflow_url
+}
@@ -1,3 +1,5 @@
-provider "scaffolding" {
-  # example configuration here
+# The API key is read from the APOLLO_KEY environment variable when
+# personal_api_key is omitted, and the endpoint from APOLLO_ENDPOINT.
+provider "apollo" {
+  endpoint = "https://graphql.api.apollographql.com/api/graphql"
 }
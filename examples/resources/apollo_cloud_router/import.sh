@@ -0,0 +1,3 @@
+# Cloud routers can be imported by graph ref, in the form <graph_id>@<variant>.
+# The configuration is imported as config_yaml; secrets are not imported.
+terraform import apollo_cloud_router.example my-graph@current
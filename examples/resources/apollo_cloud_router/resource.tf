@@ -0,0 +1,38 @@
+variable "products_token" {
+  type      = string
+  sensitive = true
+}
+
+# Configuration given as an HCL object.
+resource "apollo_cloud_router" "example" {
+  graph_id        = apollo_graph.example.graph_id
+  variant         = "current"
+  version_channel = "1.x"
+  custom_domain   = "api.example.com"
+
+  config = jsonencode({
+    headers = {
+      subgraphs = {
+        products = {
+          request = [{
+            insert = {
+              name  = "Authorization"
+              value = "$${env.PRODUCTS_TOKEN}"
+            }
+          }]
+        }
+      }
+    }
+  })
+
+  secrets = {
+    PRODUCTS_TOKEN = var.products_token
+  }
+}
+
+# Configuration given as raw YAML.
+resource "apollo_cloud_router" "staging" {
+  graph_id    = apollo_graph.example.graph_id
+  variant     = "staging"
+  config_yaml = file("${path.module}/router.yaml")
+}
@@ -0,0 +1,2 @@
+# Contract variants can be imported by graph ref, in the form <graph_id>@<name>.
+terraform import apollo_contract_variant.public my-graph@public
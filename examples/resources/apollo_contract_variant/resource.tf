@@ -0,0 +1,9 @@
+resource "apollo_contract_variant" "public" {
+  graph_id       = apollo_graph.example.graph_id
+  name           = "public"
+  source_variant = "current"
+
+  include_tags           = ["public"]
+  exclude_tags           = ["internal", "experimental"]
+  hide_unreachable_types = true
+}
@@ -0,0 +1,4 @@
+# Custom check configurations can be imported by graph ref, in the form
+# <graph_id>@<variant>. The secret is not imported and must be set in the
+# configuration.
+terraform import apollo_custom_check_configuration.example my-graph@current
@@ -0,0 +1,12 @@
+variable "governance_bot_secret" {
+  type      = string
+  sensitive = true
+}
+
+resource "apollo_custom_check_configuration" "example" {
+  graph_id     = apollo_graph.example.graph_id
+  variant      = "current"
+  enabled      = true
+  webhook_url  = "https://governance-bot.example.com/apollo/checks"
+  secret_token = var.governance_bot_secret
+}
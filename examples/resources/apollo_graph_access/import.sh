@@ -0,0 +1,2 @@
+# Grants can be imported in the form <graph_id>/<user|team>/<id>.
+terraform import apollo_graph_access.ada payments-12345/user/ada-user-id
@@ -0,0 +1,17 @@
+resource "apollo_graph" "payments" {
+  org_id                           = "my-org"
+  graph_name                       = "Payments"
+  hidden_from_uninvited_non_admins = true
+}
+
+resource "apollo_graph_access" "ada" {
+  graph_id = apollo_graph.payments.graph_id
+  user_id  = apollo_org_member.ada.id
+  role     = "CONTRIBUTOR"
+}
+
+resource "apollo_graph_access" "payments_team" {
+  graph_id = apollo_graph.payments.graph_id
+  team_id  = "payments-team"
+  role     = "OBSERVER"
+}
@@ -0,0 +1,3 @@
+# Lint configurations can be imported by graph ID. Rules are not imported; add
+# the ones you want to manage to the configuration.
+terraform import apollo_graph_lint_configuration.example my-graph
@@ -0,0 +1,15 @@
+resource "apollo_graph_lint_configuration" "example" {
+  graph_id = apollo_graph.example.graph_id
+
+  rules = {
+    FIELD_NAMES_SHOULD_BE_CAMEL_CASE    = "error"
+    TYPE_NAMES_SHOULD_BE_PASCAL_CASE    = "error"
+    ALL_ELEMENTS_REQUIRE_DESCRIPTION    = "warn"
+    DEPRECATED_DIRECTIVE_WITHOUT_REASON = "error"
+    TAG_DIRECTIVE_USES_UNKNOWN_NAME     = "error"
+    RESTY_FIELD_NAMES                   = "ignore"
+  }
+
+  ignored_tags      = ["experimental"]
+  allowed_tag_names = ["public", "internal", "experimental"]
+}
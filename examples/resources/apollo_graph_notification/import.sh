@@ -0,0 +1,2 @@
+# Subscriptions can be imported in the form <graph_id>/<subscription_id>.
+terraform import apollo_graph_notification.schema_changes my-graph/4d5e6f
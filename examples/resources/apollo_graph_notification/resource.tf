@@ -0,0 +1,12 @@
+resource "apollo_graph_notification" "schema_changes" {
+  graph_id   = apollo_graph.example.graph_id
+  variant    = "current"
+  channel_id = apollo_notification_channel.slack.id
+  events     = ["SCHEMA_CHANGE", "DAILY_REPORT"]
+}
+
+resource "apollo_graph_notification" "build_errors" {
+  graph_id   = apollo_graph.example.graph_id
+  channel_id = apollo_notification_channel.pagerduty.id
+  events     = ["BUILD_ERROR"]
+}
@@ -0,0 +1,2 @@
+# Graph variants can be imported by graph ref, in the form <graph_id>@<name>.
+terraform import apollo_graph_variant.staging my-graph@staging
@@ -0,0 +1,13 @@
+resource "apollo_graph_variant" "staging" {
+  graph_id  = apollo_graph.example.graph_id
+  name      = "staging"
+  is_public = false
+
+  preflight_script = <<-EOT
+    explorer.environment.set("token", "example");
+  EOT
+
+  default_headers = {
+    "apollographql-client-name" = "explorer"
+  }
+}
@@ -0,0 +1,3 @@
+# Channels can be imported in the form <org_id>/<channel_id>. Write-only
+# secrets such as routing_key and secret_token are not imported.
+terraform import apollo_notification_channel.slack my-org/1a2b3c
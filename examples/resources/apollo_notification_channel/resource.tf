@@ -0,0 +1,21 @@
+resource "apollo_notification_channel" "slack" {
+  org_id = "my-org"
+  name   = "#graph-alerts"
+  type   = "SLACK"
+  url    = var.slack_webhook_url
+}
+
+resource "apollo_notification_channel" "pagerduty" {
+  org_id      = "my-org"
+  name        = "graph on-call"
+  type        = "PAGERDUTY"
+  routing_key = var.pagerduty_routing_key
+}
+
+resource "apollo_notification_channel" "webhook" {
+  org_id       = "my-org"
+  name         = "schema registry"
+  type         = "WEBHOOK"
+  url          = "https://hooks.example.com/apollo"
+  secret_token = var.webhook_secret
+}
@@ -0,0 +1,2 @@
+# Invitations can be imported in the form <org_id>/<invitation_id>.
+terraform import apollo_org_invitation.ada my-org/1a2b3c
@@ -0,0 +1,5 @@
+resource "apollo_org_invitation" "ada" {
+  org_id = "my-org"
+  email  = "ada@example.com"
+  role   = "CONTRIBUTOR"
+}
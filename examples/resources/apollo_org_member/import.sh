@@ -0,0 +1,2 @@
+# Members can be imported in the form <org_id>/<email>.
+terraform import apollo_org_member.ada my-org/ada@example.com
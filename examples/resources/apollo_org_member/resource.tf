@@ -0,0 +1,7 @@
+# The user must already have joined the organization, for example by
+# accepting an apollo_org_invitation.
+resource "apollo_org_member" "ada" {
+  org_id = "my-org"
+  email  = "ada@example.com"
+  role   = "GRAPH_ADMIN"
+}
@@ -0,0 +1,2 @@
+# Persisted query lists can be imported in the form <graph_id>/<list_id>.
+terraform import apollo_persisted_query_list.web my-graph/1a2b3c
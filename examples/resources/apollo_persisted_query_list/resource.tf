@@ -0,0 +1,6 @@
+resource "apollo_persisted_query_list" "web" {
+  graph_id        = apollo_graph.example.graph_id
+  name            = "web"
+  description     = "Operations of the web client"
+  linked_variants = ["current", "staging"]
+}
@@ -0,0 +1,3 @@
+# Operations can be imported in the form <graph_id>/<list_id>. The manifest is
+# not imported, so the first plan after import shows any differences with it.
+terraform import apollo_persisted_query_list_operations.web my-graph/1a2b3c
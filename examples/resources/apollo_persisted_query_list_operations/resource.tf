@@ -0,0 +1,5 @@
+resource "apollo_persisted_query_list_operations" "web" {
+  graph_id      = apollo_graph.example.graph_id
+  list_id       = apollo_persisted_query_list.web.id
+  manifest_file = "${path.module}/persisted-query-manifest.json"
+}
@@ -0,0 +1,3 @@
+# Router secrets can be imported in the form <graph_id>@<variant>/<name>. The
+# value is not imported and must be set in the configuration.
+terraform import apollo_router_secret.products_token my-graph@current/PRODUCTS_TOKEN
@@ -0,0 +1,17 @@
+variable "products_token" {
+  type      = string
+  sensitive = true
+}
+
+resource "apollo_router_secret" "products_token" {
+  graph_id = apollo_graph.example.graph_id
+  variant  = "current"
+  name     = "PRODUCTS_TOKEN"
+  value    = var.products_token
+
+  # Bump to send the value to Apollo again, for example after rotating the
+  # token at its source.
+  rotation_triggers = {
+    rotated_on = "2024-01-15"
+  }
+}
@@ -0,0 +1,2 @@
+# Subgraphs can be imported by <graph_id>@<variant>/<name>.
+terraform import apollo_subgraph.products my-graph@current/products
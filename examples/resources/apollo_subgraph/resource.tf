@@ -0,0 +1,22 @@
+resource "apollo_subgraph" "products" {
+  graph_id    = apollo_graph.example.graph_id
+  variant     = "current"
+  name        = "products"
+  routing_url = "https://products.example.com/graphql"
+  sdl_file    = "${path.module}/schemas/products.graphql"
+}
+
+resource "apollo_subgraph" "reviews" {
+  graph_id    = apollo_graph.example.graph_id
+  name        = "reviews"
+  routing_url = "https://reviews.example.com/graphql"
+
+  sdl = <<-EOT
+    extend schema @link(url: "https://specs.apollo.dev/federation/v2.3", import: ["@key"])
+
+    type Review @key(fields: "id") {
+      id: ID!
+      body: String!
+    }
+  EOT
+}
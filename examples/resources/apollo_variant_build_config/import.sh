@@ -0,0 +1,2 @@
+# Build configs can be imported by graph ref, in the form <graph_id>@<variant>.
+terraform import apollo_variant_build_config.example my-graph@current
@@ -0,0 +1,9 @@
+resource "apollo_variant_build_config" "example" {
+  graph_id             = apollo_graph.example.graph_id
+  variant              = "current"
+  build_pipeline_track = "FED_2_9"
+}
+
+output "build_errors" {
+  value = apollo_variant_build_config.example.build_errors
+}
@@ -0,0 +1,2 @@
+# Check configurations can be imported by graph ref, in the form <graph_id>@<variant>.
+terraform import apollo_variant_check_configuration.example my-graph@current
@@ -0,0 +1,13 @@
+resource "apollo_variant_check_configuration" "example" {
+  graph_id              = apollo_graph.example.graph_id
+  variant               = "current"
+  time_window_days      = 30
+  minimum_request_count = 10
+
+  excluded_clients         = ["internal-tools"]
+  excluded_operation_names = ["IntrospectionQuery"]
+  checked_variants         = ["current", "staging"]
+
+  linting_gates_check  = true
+  proposals_gate_check = false
+}
@@ -0,0 +1,163 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// GraphRoles are the roles that can be granted on a single graph.
+var GraphRoles = []string{
+	"GRAPH_ADMIN",
+	"CONTRIBUTOR",
+	"DOCUMENTER",
+	"OBSERVER",
+	"CONSUMER",
+}
+
+const (
+	// AccessSubjectUser grants access to an individual user.
+	AccessSubjectUser = "USER"
+	// AccessSubjectTeam grants access to every member of a team.
+	AccessSubjectTeam = "TEAM"
+)
+
+// GraphAccessGrant is a role granted to a user or team on a graph.
+type GraphAccessGrant struct {
+	SubjectType string `json:"subjectType"`
+	SubjectID   string `json:"subjectId"`
+	Role        string `json:"role"`
+}
+
+// GraphAccessRef returns the identifier Terraform uses for a grant on a graph,
+// in the form graph/type/subject.
+func GraphAccessRef(graphId, subjectType, subjectId string) string {
+	return graphId + "/" + strings.ToLower(subjectType) + "/" + subjectId
+}
+
+// ParseGraphAccessRef splits a reference produced by GraphAccessRef into its
+// graph ID, subject type and subject ID.
+func ParseGraphAccessRef(ref string) (string, string, string, error) {
+	parts := strings.SplitN(ref, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("expected <graph_id>/<user|team>/<id>, got %q", ref)
+	}
+
+	subjectType := strings.ToUpper(parts[1])
+	if subjectType != AccessSubjectUser && subjectType != AccessSubjectTeam {
+		return "", "", "", fmt.Errorf("expected <graph_id>/<user|team>/<id>, got %q", ref)
+	}
+
+	return parts[0], subjectType, parts[2], nil
+}
+
+var listGraphAccessOperation = Operation{
+	Name: "ListGraphAccess",
+	Query: `query ListGraphAccess($graphId: ID!) {
+  service(id: $graphId) {
+    accessGrants {
+      subjectType
+      subjectId
+      role
+    }
+  }
+}`,
+}
+
+var grantGraphAccessOperation = Operation{
+	Name: "GrantGraphAccess",
+	Query: `mutation GrantGraphAccess($graphId: ID!, $subjectType: AccessSubjectType!, $subjectId: ID!, $role: UserPermission!) {
+  service(id: $graphId) {
+    grantAccess(subjectType: $subjectType, subjectId: $subjectId, role: $role) {
+      subjectType
+      subjectId
+      role
+    }
+  }
+}`,
+}
+
+var revokeGraphAccessOperation = Operation{
+	Name: "RevokeGraphAccess",
+	Query: `mutation RevokeGraphAccess($graphId: ID!, $subjectType: AccessSubjectType!, $subjectId: ID!) {
+  service(id: $graphId) {
+    revokeAccess(subjectType: $subjectType, subjectId: $subjectId)
+  }
+}`,
+}
+
+// GetGraphAccess returns the role granted to the user or team subjectId on
+// graphId, or ErrNotFound if there is no such grant.
+func (cl *Client) GetGraphAccess(ctx context.Context, graphId, subjectType, subjectId string) (*GraphAccessGrant, error) {
+	var response struct {
+		Service *struct {
+			AccessGrants []GraphAccessGrant `json:"accessGrants"`
+		} `json:"service"`
+	}
+
+	err := cl.Query(ctx, listGraphAccessOperation, Variables{"graphId": graphId}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Service == nil {
+		return nil, ErrNotFound
+	}
+
+	for i := range response.Service.AccessGrants {
+		grant := &response.Service.AccessGrants[i]
+		if grant.SubjectType == subjectType && grant.SubjectID == subjectId {
+			return grant, nil
+		}
+	}
+
+	return nil, ErrNotFound
+}
+
+// GrantGraphAccess grants role on graphId to the user or team subjectId,
+// replacing any role it had before.
+func (cl *Client) GrantGraphAccess(ctx context.Context, graphId, subjectType, subjectId, role string) (*GraphAccessGrant, error) {
+	var response struct {
+		Service *struct {
+			GrantAccess *GraphAccessGrant `json:"grantAccess"`
+		} `json:"service"`
+	}
+
+	err := cl.Query(ctx, grantGraphAccessOperation, Variables{
+		"graphId":     graphId,
+		"subjectType": subjectType,
+		"subjectId":   subjectId,
+		"role":        role,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Service == nil || response.Service.GrantAccess == nil {
+		return nil, ErrNotFound
+	}
+
+	return response.Service.GrantAccess, nil
+}
+
+// RevokeGraphAccess removes the role granted to the user or team subjectId on
+// graphId.
+func (cl *Client) RevokeGraphAccess(ctx context.Context, graphId, subjectType, subjectId string) error {
+	var response struct {
+		Service *struct {
+			RevokeAccess interface{} `json:"revokeAccess"`
+		} `json:"service"`
+	}
+
+	err := cl.Query(ctx, revokeGraphAccessOperation, Variables{
+		"graphId":     graphId,
+		"subjectType": subjectType,
+		"subjectId":   subjectId,
+	}, &response)
+	if err != nil {
+		return err
+	}
+	if response.Service == nil {
+		return ErrNotFound
+	}
+
+	return nil
+}
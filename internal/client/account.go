@@ -0,0 +1,54 @@
+package client
+
+import "context"
+
+var accountCapabilitiesOperation = Operation{
+	Name: "AccountCapabilities",
+	Query: `query AccountCapabilities {
+  me {
+    ... on User {
+      memberships {
+        account {
+          id
+          currentPlan {
+            capabilities {
+              contracts
+            }
+          }
+        }
+      }
+    }
+  }
+}`,
+}
+
+// HasContractsCapability reports whether the configured API key belongs to a
+// member of at least one organization whose plan enables contracts.
+func (cl *Client) HasContractsCapability(ctx context.Context) (bool, error) {
+	var response struct {
+		Me *struct {
+			Memberships []struct {
+				Account struct {
+					ID          string       `json:"id"`
+					CurrentPlan *BillingPlan `json:"currentPlan"`
+				} `json:"account"`
+			} `json:"memberships"`
+		} `json:"me"`
+	}
+
+	err := cl.Query(ctx, accountCapabilitiesOperation, nil, &response)
+	if err != nil {
+		return false, err
+	}
+	if response.Me == nil {
+		return false, ErrNotFound
+	}
+
+	for _, membership := range response.Me.Memberships {
+		if plan := membership.Account.CurrentPlan; plan != nil && plan.Capabilities.Contracts {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,208 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// ApiKey is a graph API key.
+type ApiKey struct {
+	ID      string `json:"id"`
+	KeyName string `json:"keyName"`
+	Role    string `json:"role"`
+	Token   string `json:"token"`
+}
+
+// DefaultApiKeyRole is the role Apollo assigns to graph API keys created
+// without one.
+const DefaultApiKeyRole = "GRAPH_ADMIN"
+
+// ApiKeyRoles are the roles a graph API key can be created with.
+var ApiKeyRoles = []string{
+	"GRAPH_ADMIN",
+	"CONTRIBUTOR",
+	"DOCUMENTER",
+	"OBSERVER",
+	"CONSUMER",
+}
+
+var createApiKeyOperation = Operation{
+	Name: "CreateApiKey",
+	Query: `mutation CreateApiKey($id: ID!, $keyName: String!, $role: UserPermission!) {
+  service(id: $id) {
+    newKey(keyName: $keyName, role: $role) {
+      id
+      keyName
+      role
+      token
+    }
+  }
+}`,
+}
+
+var listApiKeysOperation = Operation{
+	Name: "ListApiKeys",
+	Query: `query ListApiKeys($id: ID!) {
+  service(id: $id) {
+    apiKeys {
+      id
+      keyName
+      role
+    }
+  }
+}`,
+}
+
+var renameApiKeyOperation = Operation{
+	Name: "RenameApiKey",
+	Query: `mutation RenameApiKey($graphId: ID!, $id: ID!, $keyName: String!) {
+  service(id: $graphId) {
+    renameKey(id: $id, newKeyName: $keyName) {
+      id
+      keyName
+      role
+    }
+  }
+}`,
+}
+
+var removeApiKeyOperation = Operation{
+	Name: "RemoveApiKey",
+	Query: `mutation RemoveApiKey($graphId: ID!, $id: ID!) {
+  service(id: $graphId) {
+    removeKey(id: $id)
+  }
+}`,
+}
+
+// CreateApiKey creates a new API key named keyName with the given role for the
+// graph graphId.
+func (cl *Client) CreateApiKey(ctx context.Context, graphId, keyName, role string) (*ApiKey, error) {
+	var response struct {
+		Service *struct {
+			NewKey *ApiKey `json:"newKey"`
+		} `json:"service"`
+	}
+
+	err := cl.Query(ctx, createApiKeyOperation, Variables{
+		"id":      graphId,
+		"keyName": keyName,
+		"role":    role,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Service == nil || response.Service.NewKey == nil {
+		return nil, ErrNotFound
+	}
+
+	return response.Service.NewKey, nil
+}
+
+// ListApiKeys returns the API keys of the graph graphId. Tokens are only
+// returned in full by CreateApiKey, so they are not listed.
+func (cl *Client) ListApiKeys(ctx context.Context, graphId string) ([]ApiKey, error) {
+	var response struct {
+		Service *struct {
+			ApiKeys []ApiKey `json:"apiKeys"`
+		} `json:"service"`
+	}
+
+	err := cl.Query(ctx, listApiKeysOperation, Variables{"id": graphId}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Service == nil {
+		return nil, ErrNotFound
+	}
+
+	return response.Service.ApiKeys, nil
+}
+
+// GetApiKey returns the API key keyId of the graph graphId, or ErrNotFound if
+// either does not exist.
+func (cl *Client) GetApiKey(ctx context.Context, graphId, keyId string) (*ApiKey, error) {
+	keys, err := cl.ListApiKeys(ctx, graphId)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range keys {
+		if keys[i].ID == keyId {
+			return &keys[i], nil
+		}
+	}
+
+	return nil, ErrNotFound
+}
+
+// GetApiKeyByName returns the API key named keyName of the graph graphId, or
+// ErrNotFound if either does not exist. Names are not unique, so it fails if
+// several keys share the name.
+func (cl *Client) GetApiKeyByName(ctx context.Context, graphId, keyName string) (*ApiKey, error) {
+	keys, err := cl.ListApiKeys(ctx, graphId)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *ApiKey
+	for i := range keys {
+		if keys[i].KeyName != keyName {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("graph %s has several API keys named %q", graphId, keyName)
+		}
+		found = &keys[i]
+	}
+	if found == nil {
+		return nil, ErrNotFound
+	}
+
+	return found, nil
+}
+
+// RenameApiKey renames the API key keyId of the graph graphId.
+func (cl *Client) RenameApiKey(ctx context.Context, graphId, keyId, keyName string) (*ApiKey, error) {
+	var response struct {
+		Service *struct {
+			RenameKey *ApiKey `json:"renameKey"`
+		} `json:"service"`
+	}
+
+	err := cl.Query(ctx, renameApiKeyOperation, Variables{
+		"graphId": graphId,
+		"id":      keyId,
+		"keyName": keyName,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Service == nil || response.Service.RenameKey == nil {
+		return nil, ErrNotFound
+	}
+
+	return response.Service.RenameKey, nil
+}
+
+// RemoveApiKey revokes the API key keyId of the graph graphId.
+func (cl *Client) RemoveApiKey(ctx context.Context, graphId, keyId string) error {
+	var response struct {
+		Service *struct {
+			RemoveKey interface{} `json:"removeKey"`
+		} `json:"service"`
+	}
+
+	err := cl.Query(ctx, removeApiKeyOperation, Variables{
+		"graphId": graphId,
+		"id":      keyId,
+	}, &response)
+	if err != nil {
+		return err
+	}
+	if response.Service == nil {
+		return ErrNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package client
+
+import "context"
+
+// BuildPipelineTracks are the composition build pipelines a variant can be
+// pinned to. FED_1 and FED_2 follow the latest release of their major
+// version; the other tracks pin a minor version.
+var BuildPipelineTracks = []string{
+	"FED_1",
+	"FED_1_0",
+	"FED_1_1",
+	"FED_2",
+	"FED_2_0",
+	"FED_2_1",
+	"FED_2_2",
+	"FED_2_3",
+	"FED_2_4",
+	"FED_2_5",
+	"FED_2_6",
+	"FED_2_7",
+	"FED_2_8",
+	"FED_2_9",
+	"FED_2_10",
+	"FED_2_11",
+}
+
+// BuildConfig is the build pipeline track of a variant and its latest launch.
+type BuildConfig struct {
+	BuildPipelineTrack string  `json:"buildPipelineTrack"`
+	LatestLaunch       *Launch `json:"latestLaunch"`
+}
+
+const buildConfigFields = `
+      buildPipelineTrack
+      latestLaunch {` + launchFields + `
+      }`
+
+var getBuildConfigOperation = Operation{
+	Name: "GetBuildConfig",
+	Query: `query GetBuildConfig($graphId: ID!, $name: String!) {
+  service(id: $graphId) {
+    variant(name: $name) {` + buildConfigFields + `
+    }
+  }
+}`,
+}
+
+var updateBuildPipelineTrackOperation = Operation{
+	Name: "UpdateBuildPipelineTrack",
+	Query: `mutation UpdateBuildPipelineTrack($ref: ID!, $track: BuildPipelineTrack!) {
+  variant(ref: $ref) {
+    updateVariantFederationVersion(version: $track) {` + buildConfigFields + `
+    }
+  }
+}`,
+}
+
+// GetBuildConfig returns the build configuration of the variant name of
+// graphId, or ErrNotFound if the variant does not exist.
+func (cl *Client) GetBuildConfig(ctx context.Context, graphId, name string) (*BuildConfig, error) {
+	var response struct {
+		Service *struct {
+			Variant *BuildConfig `json:"variant"`
+		} `json:"service"`
+	}
+
+	err := cl.Query(ctx, getBuildConfigOperation, Variables{"graphId": graphId, "name": name}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Service == nil || response.Service.Variant == nil {
+		return nil, ErrNotFound
+	}
+
+	return response.Service.Variant, nil
+}
+
+// UpdateBuildPipelineTrack pins the variant name of graphId to track, which
+// starts a new launch.
+func (cl *Client) UpdateBuildPipelineTrack(ctx context.Context, graphId, name, track string) (*BuildConfig, error) {
+	var response struct {
+		Variant *struct {
+			UpdateVariantFederationVersion *BuildConfig `json:"updateVariantFederationVersion"`
+		} `json:"variant"`
+	}
+
+	err := cl.Query(ctx, updateBuildPipelineTrackOperation, Variables{"ref": VariantRef(graphId, name), "track": track}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Variant == nil || response.Variant.UpdateVariantFederationVersion == nil {
+		return nil, ErrNotFound
+	}
+
+	return response.Variant.UpdateVariantFederationVersion, nil
+}
@@ -0,0 +1,122 @@
+package client
+
+import "context"
+
+// ChangeSeverityFailure is the severity Apollo assigns to breaking changes.
+const ChangeSeverityFailure = "FAILURE"
+
+// SchemaChange is a single change found by a schema check.
+type SchemaChange struct {
+	Code        string `json:"code"`
+	Severity    string `json:"severity"`
+	Description string `json:"description"`
+}
+
+// AffectedOperation is a client operation affected by a schema check.
+type AffectedOperation struct {
+	ID   string  `json:"id"`
+	Name *string `json:"name"`
+}
+
+// SchemaCheckResult is the outcome of checking a proposed subgraph schema.
+type SchemaCheckResult struct {
+	WorkflowID        string
+	TargetURL         string
+	Changes           []SchemaChange
+	AffectedQueries   []AffectedOperation
+	CompositionErrors []CompositionError
+}
+
+// BreakingChanges returns the number of changes with FAILURE severity.
+func (r *SchemaCheckResult) BreakingChanges() int {
+	count := 0
+	for _, change := range r.Changes {
+		if change.Severity == ChangeSeverityFailure {
+			count++
+		}
+	}
+	return count
+}
+
+// Passed reports whether the check found no breaking changes and no
+// composition errors.
+func (r *SchemaCheckResult) Passed() bool {
+	return len(r.CompositionErrors) == 0 && r.BreakingChanges() == 0
+}
+
+var checkSubgraphSchemaOperation = Operation{
+	Name: "CheckSubgraphSchema",
+	Query: `mutation CheckSubgraphSchema($graphId: ID!, $variant: String!, $name: String!, $sdl: String!) {
+  service(id: $graphId) {
+    checkPartialSchema(graphVariant: $variant, implementingServiceName: $name, partialSchema: {sdl: $sdl}) {
+      workflowId
+      compositionValidationResult {
+        errors {
+          message
+          code
+        }
+      }
+      checkSchemaResult {
+        targetUrl
+        diffToPrevious {
+          changes {
+            code
+            severity
+            description
+          }
+          affectedQueries {
+            id
+            name
+          }
+        }
+      }
+    }
+  }
+}`,
+}
+
+// CheckSubgraphSchema runs a schema check of sdl as the new schema of the
+// subgraph name in the given graph variant.
+func (cl *Client) CheckSubgraphSchema(ctx context.Context, graphId, variant, name, sdl string) (*SchemaCheckResult, error) {
+	var response struct {
+		Service *struct {
+			CheckPartialSchema *struct {
+				WorkflowID                  string             `json:"workflowId"`
+				CompositionValidationResult *compositionResult `json:"compositionValidationResult"`
+				CheckSchemaResult           *struct {
+					TargetURL      string `json:"targetUrl"`
+					DiffToPrevious struct {
+						Changes         []SchemaChange      `json:"changes"`
+						AffectedQueries []AffectedOperation `json:"affectedQueries"`
+					} `json:"diffToPrevious"`
+				} `json:"checkSchemaResult"`
+			} `json:"checkPartialSchema"`
+		} `json:"service"`
+	}
+
+	err := cl.Query(ctx, checkSubgraphSchemaOperation, Variables{
+		"graphId": graphId,
+		"variant": variant,
+		"name":    name,
+		"sdl":     sdl,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Service == nil || response.Service.CheckPartialSchema == nil {
+		return nil, ErrNotFound
+	}
+
+	check := response.Service.CheckPartialSchema
+	result := &SchemaCheckResult{WorkflowID: check.WorkflowID}
+	if check.CompositionValidationResult != nil {
+		result.CompositionErrors = check.CompositionValidationResult.Errors
+	}
+	if check.CheckSchemaResult != nil {
+		result.TargetURL = check.CheckSchemaResult.TargetURL
+		result.Changes = check.CheckSchemaResult.DiffToPrevious.Changes
+		result.AffectedQueries = check.CheckSchemaResult.DiffToPrevious.AffectedQueries
+	}
+
+	return result, nil
+}
@@ -0,0 +1,126 @@
+package client
+
+import "context"
+
+// CheckConfiguration controls how schema checks against a variant decide
+// whether a change is breaking.
+type CheckConfiguration struct {
+	// TimeRangeSeconds is how far back operation usage is considered.
+	TimeRangeSeconds int64 `json:"timeRangeSeconds"`
+	// OperationCountThreshold is the minimum number of requests an operation
+	// needs in the time range for a change affecting it to be flagged.
+	OperationCountThreshold int64    `json:"operationCountThreshold"`
+	ExcludedClients         []string `json:"excludedClients"`
+	ExcludedOperationNames  []string `json:"excludedOperationNames"`
+	// IncludedVariants are the variants whose operation usage is checked
+	// against. Empty means the checked variant itself.
+	IncludedVariants   []string `json:"includedVariants"`
+	LintFailsCheck     bool     `json:"lintFailsCheck"`
+	ProposalFailsCheck bool     `json:"proposalFailsCheck"`
+}
+
+const checkConfigurationFields = `
+      timeRangeSeconds
+      operationCountThreshold
+      excludedClients
+      excludedOperationNames
+      includedVariants
+      lintFailsCheck
+      proposalFailsCheck`
+
+var getCheckConfigurationOperation = Operation{
+	Name: "GetCheckConfiguration",
+	Query: `query GetCheckConfiguration($graphId: ID!, $name: String!) {
+  service(id: $graphId) {
+    variant(name: $name) {
+      checkConfiguration {` + checkConfigurationFields + `
+      }
+    }
+  }
+}`,
+}
+
+var updateCheckConfigurationOperation = Operation{
+	Name: "UpdateCheckConfiguration",
+	Query: `mutation UpdateCheckConfiguration($ref: ID!, $configuration: CheckConfigurationInput!) {
+  variant(ref: $ref) {
+    updateCheckConfiguration(configuration: $configuration) {` + checkConfigurationFields + `
+    }
+  }
+}`,
+}
+
+var resetCheckConfigurationOperation = Operation{
+	Name: "ResetCheckConfiguration",
+	Query: `mutation ResetCheckConfiguration($ref: ID!) {
+  variant(ref: $ref) {
+    resetCheckConfiguration {` + checkConfigurationFields + `
+    }
+  }
+}`,
+}
+
+// GetCheckConfiguration returns the check configuration of the variant name of
+// graphId, or ErrNotFound if the variant does not exist.
+func (cl *Client) GetCheckConfiguration(ctx context.Context, graphId, name string) (*CheckConfiguration, error) {
+	var response struct {
+		Service *struct {
+			Variant *struct {
+				CheckConfiguration *CheckConfiguration `json:"checkConfiguration"`
+			} `json:"variant"`
+		} `json:"service"`
+	}
+
+	err := cl.Query(ctx, getCheckConfigurationOperation, Variables{"graphId": graphId, "name": name}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Service == nil || response.Service.Variant == nil || response.Service.Variant.CheckConfiguration == nil {
+		return nil, ErrNotFound
+	}
+
+	return response.Service.Variant.CheckConfiguration, nil
+}
+
+// UpdateCheckConfiguration replaces the check configuration of the variant
+// name of graphId.
+func (cl *Client) UpdateCheckConfiguration(ctx context.Context, graphId, name string, configuration CheckConfiguration) (*CheckConfiguration, error) {
+	var response struct {
+		Variant *struct {
+			UpdateCheckConfiguration *CheckConfiguration `json:"updateCheckConfiguration"`
+		} `json:"variant"`
+	}
+
+	err := cl.Query(ctx, updateCheckConfigurationOperation, Variables{
+		"ref":           VariantRef(graphId, name),
+		"configuration": configuration,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Variant == nil || response.Variant.UpdateCheckConfiguration == nil {
+		return nil, ErrNotFound
+	}
+
+	return response.Variant.UpdateCheckConfiguration, nil
+}
+
+// ResetCheckConfiguration restores the default check configuration of the
+// variant name of graphId.
+func (cl *Client) ResetCheckConfiguration(ctx context.Context, graphId, name string) error {
+	var response struct {
+		Variant *struct {
+			ResetCheckConfiguration *CheckConfiguration `json:"resetCheckConfiguration"`
+		} `json:"variant"`
+	}
+
+	err := cl.Query(ctx, resetCheckConfigurationOperation, Variables{"ref": VariantRef(graphId, name)}, &response)
+	if err != nil {
+		return err
+	}
+	if response.Variant == nil {
+		return ErrNotFound
+	}
+
+	return nil
+}
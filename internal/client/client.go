@@ -1,29 +1,121 @@
 package client
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/machinebox/graphql"
 )
 
+// DefaultEndpoint is the Apollo Platform API endpoint.
+const DefaultEndpoint = "https://graphql.api.apollographql.com/api/graphql"
+
+// clientName identifies this provider to the Apollo Platform API.
+const clientName = "terraform-provider-apollo"
+
 type Client struct {
 	ApiKey            string
+	Endpoint          string
 	EnterPriseEnabled bool
-	GraphClient       *graphql.Client
+	HttpClient        *http.Client
+}
+
+// Operation is a named GraphQL document sent to the Apollo Platform API.
+type Operation struct {
+	Name  string
+	Query string
+}
+
+// Variables holds the GraphQL variables of a single request.
+type Variables map[string]interface{}
+
+type graphqlRequest struct {
+	OperationName string    `json:"operationName"`
+	Query         string    `json:"query"`
+	Variables     Variables `json:"variables,omitempty"`
+}
+
+type graphqlResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors Errors          `json:"errors"`
 }
 
 func (cl *Client) Init() {
-	cl.GraphClient = graphql.NewClient("https://graphql.api.apollographql.com/api/graphql")
+	if cl.Endpoint == "" {
+		cl.Endpoint = DefaultEndpoint
+	}
+	if cl.HttpClient == nil {
+		cl.HttpClient = &http.Client{Timeout: 60 * time.Second}
+	}
 }
 
-func (cl *Client) Query(c context.Context, q string, response interface{}) error {
+// Query runs op with the given variables and decodes the "data" member of the
+// response into response. GraphQL errors are returned as Errors, and non-2xx
+// responses without any GraphQL errors are returned as *HTTPError.
+func (cl *Client) Query(c context.Context, op Operation, variables Variables, response interface{}) error {
+	body, err := json.Marshal(graphqlRequest{
+		OperationName: op.Name,
+		Query:         op.Query,
+		Variables:     variables,
+	})
+	if err != nil {
+		return fmt.Errorf("encoding %s request: %w", op.Name, err)
+	}
+
+	request, err := http.NewRequestWithContext(c, http.MethodPost, cl.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("building %s request: %w", op.Name, err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("X-API-Key", cl.ApiKey)
+	request.Header.Set("apollographql-client-name", clientName)
+
+	c = tflog.SetField(c, "operation", op.Name)
+	tflog.Debug(c, "sending Apollo API request")
+
+	httpResponse, err := cl.HttpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("sending %s request: %w", op.Name, err)
+	}
+	defer httpResponse.Body.Close()
+
+	raw, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return fmt.Errorf("reading %s response: %w", op.Name, err)
+	}
+
+	c = tflog.SetField(c, "status", httpResponse.StatusCode)
+	tflog.Debug(c, "received Apollo API response")
+
+	var result graphqlResponse
+	if err := json.Unmarshal(raw, &result); err != nil {
+		if httpResponse.StatusCode/100 != 2 {
+			return &HTTPError{StatusCode: httpResponse.StatusCode, Body: string(raw)}
+		}
+		return fmt.Errorf("decoding %s response: %w", op.Name, err)
+	}
+
+	if len(result.Errors) > 0 {
+		return result.Errors
+	}
+
+	if httpResponse.StatusCode/100 != 2 {
+		return &HTTPError{StatusCode: httpResponse.StatusCode, Body: string(raw)}
+	}
 
-	c = tflog.SetField(c, "query", q)
-	graphqlRequest := graphql.NewRequest(q)
-	graphqlRequest.Header.Add("X-API-Key", cl.ApiKey)
-	c = tflog.SetField(c, "response", response)
+	if response == nil || len(result.Data) == 0 {
+		return nil
+	}
 
-	return cl.GraphClient.Run(c, graphqlRequest, &response)
+	if err := json.Unmarshal(result.Data, response); err != nil {
+		return fmt.Errorf("decoding %s response data: %w", op.Name, err)
+	}
 
+	return nil
 }
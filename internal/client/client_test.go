@@ -0,0 +1,531 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+// testClient returns a Client whose requests are answered by handler instead
+// of the Apollo API.
+func testClient(t *testing.T, handler func(t *testing.T, req graphqlRequest) (int, string)) *Client {
+	t.Helper()
+
+	cl := &Client{
+		ApiKey: "test-key",
+		HttpClient: &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			if got := r.Header.Get("X-API-Key"); got != "test-key" {
+				t.Errorf("X-API-Key header = %q, want %q", got, "test-key")
+			}
+
+			var req graphqlRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Fatalf("decoding request: %s", err)
+			}
+
+			status, body := handler(t, req)
+			return &http.Response{
+				StatusCode: status,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(body)),
+			}, nil
+		})},
+	}
+	cl.Init()
+
+	return cl
+}
+
+func TestCreateGraphSendsVariables(t *testing.T) {
+	cl := testClient(t, func(t *testing.T, req graphqlRequest) (int, string) {
+		if req.OperationName != "CreateGraph" {
+			t.Errorf("operationName = %q, want CreateGraph", req.OperationName)
+		}
+		if got := req.Variables["name"]; got != `my "quoted" graph` {
+			t.Errorf("name variable = %v, want the unescaped graph name", got)
+		}
+		return http.StatusOK, `{"data":{"newService":{"id":"graph-1","name":"my \"quoted\" graph","title":"my \"quoted\" graph","account":{"id":"org","name":"Org"}}}}`
+	})
+
+	graph, err := cl.CreateGraph(context.Background(), "org", "graph-1", `my "quoted" graph`, false)
+	if err != nil {
+		t.Fatalf("CreateGraph returned error: %s", err)
+	}
+	if graph.ID != "graph-1" || graph.Account == nil || graph.Account.ID != "org" {
+		t.Errorf("unexpected graph: %+v", graph)
+	}
+}
+
+func TestQueryReturnsGraphQLErrors(t *testing.T) {
+	cl := testClient(t, func(t *testing.T, req graphqlRequest) (int, string) {
+		return http.StatusOK, `{"data":null,"errors":[{"message":"Service already exists","extensions":{"code":"BAD_USER_INPUT"}}]}`
+	})
+
+	_, err := cl.CreateGraph(context.Background(), "org", "graph-1", "graph", false)
+
+	var gqlErrors Errors
+	if !errors.As(err, &gqlErrors) {
+		t.Fatalf("expected Errors, got %T: %v", err, err)
+	}
+	if !gqlErrors.HasCode("BAD_USER_INPUT") {
+		t.Errorf("expected BAD_USER_INPUT code in %v", gqlErrors)
+	}
+}
+
+func TestQueryReturnsHTTPError(t *testing.T) {
+	cl := testClient(t, func(t *testing.T, req graphqlRequest) (int, string) {
+		return http.StatusBadGateway, "upstream unavailable"
+	})
+
+	err := cl.DeleteGraph(context.Background(), "graph-1")
+
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusBadGateway {
+		t.Fatalf("expected HTTPError with status 502, got %T: %v", err, err)
+	}
+}
+
+func TestDeleteGraphNotFound(t *testing.T) {
+	cl := testClient(t, func(t *testing.T, req graphqlRequest) (int, string) {
+		return http.StatusOK, `{"data":{"service":null}}`
+	})
+
+	err := cl.DeleteGraph(context.Background(), "graph-1")
+	if !IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestQueryUsesConfiguredEndpoint(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/graphql" {
+			t.Errorf("request path = %q, want /graphql", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"data":{"service":{"delete":null}}}`)
+	}))
+	defer server.Close()
+
+	cl := &Client{ApiKey: "test-key", Endpoint: server.URL + "/graphql"}
+	cl.Init()
+
+	if err := cl.DeleteGraph(context.Background(), "graph-1"); err != nil {
+		t.Fatalf("DeleteGraph returned error: %s", err)
+	}
+}
+
+func TestInitDefaultsEndpoint(t *testing.T) {
+	cl := &Client{}
+	cl.Init()
+
+	if cl.Endpoint != DefaultEndpoint {
+		t.Errorf("Endpoint = %q, want %q", cl.Endpoint, DefaultEndpoint)
+	}
+}
+
+func TestGetGraphNotFound(t *testing.T) {
+	cl := testClient(t, func(t *testing.T, req graphqlRequest) (int, string) {
+		if req.OperationName != "GetGraph" {
+			t.Errorf("operationName = %q, want GetGraph", req.OperationName)
+		}
+		return http.StatusOK, `{"data":{"service":null}}`
+	})
+
+	_, err := cl.GetGraph(context.Background(), "graph-1")
+	if !IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestGetApiKey(t *testing.T) {
+	cl := testClient(t, func(t *testing.T, req graphqlRequest) (int, string) {
+		return http.StatusOK, `{"data":{"service":{"apiKeys":[{"id":"key-1","keyName":"one"},{"id":"key-2","keyName":"two"}]}}}`
+	})
+
+	key, err := cl.GetApiKey(context.Background(), "graph-1", "key-2")
+	if err != nil {
+		t.Fatalf("GetApiKey returned error: %s", err)
+	}
+	if key.KeyName != "two" {
+		t.Errorf("KeyName = %q, want two", key.KeyName)
+	}
+
+	if _, err := cl.GetApiKey(context.Background(), "graph-1", "key-3"); !IsNotFound(err) {
+		t.Errorf("expected not found error for a missing key, got %v", err)
+	}
+}
+
+func TestGetApiKeyByName(t *testing.T) {
+	cl := testClient(t, func(t *testing.T, req graphqlRequest) (int, string) {
+		return http.StatusOK, `{"data":{"service":{"apiKeys":[{"id":"key-1","keyName":"one"},{"id":"key-2","keyName":"two"},{"id":"key-3","keyName":"two"}]}}}`
+	})
+
+	key, err := cl.GetApiKeyByName(context.Background(), "graph-1", "one")
+	if err != nil {
+		t.Fatalf("GetApiKeyByName returned error: %s", err)
+	}
+	if key.ID != "key-1" {
+		t.Errorf("ID = %q, want key-1", key.ID)
+	}
+
+	if _, err := cl.GetApiKeyByName(context.Background(), "graph-1", "two"); err == nil || IsNotFound(err) {
+		t.Errorf("expected an error for a name shared by several keys, got %v", err)
+	}
+	if _, err := cl.GetApiKeyByName(context.Background(), "graph-1", "three"); !IsNotFound(err) {
+		t.Errorf("expected not found error for a missing key, got %v", err)
+	}
+}
+
+func TestParseVariantRef(t *testing.T) {
+	graphId, name, err := ParseVariantRef(VariantRef("my-graph", "staging"))
+	if err != nil || graphId != "my-graph" || name != "staging" {
+		t.Errorf("ParseVariantRef round trip = (%q, %q, %v)", graphId, name, err)
+	}
+
+	for _, ref := range []string{"my-graph", "@staging", "my-graph@"} {
+		if _, _, err := ParseVariantRef(ref); err == nil {
+			t.Errorf("ParseVariantRef(%q) returned no error", ref)
+		}
+	}
+}
+
+func TestPublishSubgraphReturnsCompositionErrors(t *testing.T) {
+	cl := testClient(t, func(t *testing.T, req graphqlRequest) (int, string) {
+		if got := req.Variables["sdl"]; got != "type Query { a: Int }" {
+			t.Errorf("sdl variable = %v", got)
+		}
+		return http.StatusOK, `{"data":{"service":{"publishSubgraph":{"errors":[{"message":"Field \"Query.a\" conflicts","code":"INVALID_FIELD_SHARING"}]}}}}`
+	})
+
+	compositionErrors, err := cl.PublishSubgraph(context.Background(), "graph-1", "current", "a", "https://a.example.com", "terraform", "type Query { a: Int }")
+	if err != nil {
+		t.Fatalf("PublishSubgraph returned error: %s", err)
+	}
+	if len(compositionErrors) != 1 || compositionErrors[0].Code != "INVALID_FIELD_SHARING" {
+		t.Errorf("unexpected composition errors: %+v", compositionErrors)
+	}
+}
+
+func TestParseSubgraphRef(t *testing.T) {
+	graphId, variant, name, err := ParseSubgraphRef(SubgraphRef("my-graph", "current", "products"))
+	if err != nil || graphId != "my-graph" || variant != "current" || name != "products" {
+		t.Errorf("ParseSubgraphRef round trip = (%q, %q, %q, %v)", graphId, variant, name, err)
+	}
+
+	for _, ref := range []string{"my-graph@current", "my-graph/products", "my-graph@current/"} {
+		if _, _, _, err := ParseSubgraphRef(ref); err == nil {
+			t.Errorf("ParseSubgraphRef(%q) returned no error", ref)
+		}
+	}
+}
+
+func TestCheckSubgraphSchemaCountsBreakingChanges(t *testing.T) {
+	cl := testClient(t, func(t *testing.T, req graphqlRequest) (int, string) {
+		return http.StatusOK, `{"data":{"service":{"checkPartialSchema":{
+			"workflowId":"wf-1",
+			"compositionValidationResult":{"errors":[]},
+			"checkSchemaResult":{"targetUrl":"https://studio.apollographql.com/check/wf-1","diffToPrevious":{
+				"changes":[
+					{"code":"FIELD_REMOVED","severity":"FAILURE","description":"Field Query.a was removed"},
+					{"code":"FIELD_ADDED","severity":"NOTICE","description":"Field Query.b was added"}
+				],
+				"affectedQueries":[{"id":"op-1","name":"GetA"}]
+			}}
+		}}}}`
+	})
+
+	result, err := cl.CheckSubgraphSchema(context.Background(), "graph-1", "current", "a", "type Query { b: Int }")
+	if err != nil {
+		t.Fatalf("CheckSubgraphSchema returned error: %s", err)
+	}
+	if result.BreakingChanges() != 1 || result.Passed() {
+		t.Errorf("BreakingChanges() = %d, Passed() = %t, want 1 and false", result.BreakingChanges(), result.Passed())
+	}
+	if len(result.AffectedQueries) != 1 || result.TargetURL == "" {
+		t.Errorf("unexpected result: %+v", result)
+	}
+}
+
+func TestHasContractsCapability(t *testing.T) {
+	cl := testClient(t, func(t *testing.T, req graphqlRequest) (int, string) {
+		return http.StatusOK, `{"data":{"me":{"memberships":[
+			{"account":{"id":"free-org","currentPlan":{"tier":"FREE","capabilities":{"contracts":false}}}},
+			{"account":{"id":"big-org","currentPlan":{"tier":"ENTERPRISE_INTERNAL","capabilities":{"contracts":true}}}}
+		]}}}`
+	})
+
+	contracts, err := cl.HasContractsCapability(context.Background())
+	if err != nil {
+		t.Fatalf("HasContractsCapability returned error: %s", err)
+	}
+	if !contracts {
+		t.Error("expected the contracts capability to be detected")
+	}
+}
+
+func TestUpsertContractVariantReturnsErrorMessages(t *testing.T) {
+	cl := testClient(t, func(t *testing.T, req graphqlRequest) (int, string) {
+		if got := req.Variables["sourceVariant"]; got != "graph-1@current" {
+			t.Errorf("sourceVariant variable = %v, want graph-1@current", got)
+		}
+		return http.StatusOK, `{"data":{"service":{"upsertContractVariant":{"errorMessages":["source variant has no schema"]}}}}`
+	})
+
+	err := cl.UpsertContractVariant(context.Background(), "graph-1", "public", "current", ContractFilter{Include: []string{"public"}})
+	if err == nil || err.Error() != "source variant has no schema" {
+		t.Fatalf("expected upsert error message, got %v", err)
+	}
+}
+
+func TestGetOrganization(t *testing.T) {
+	cl := testClient(t, func(t *testing.T, req graphqlRequest) (int, string) {
+		return http.StatusOK, `{"data":{"organization":{"id":"org","name":"Org","currentPlan":{"id":"ent","name":"Enterprise","tier":"ENTERPRISE","capabilities":{"contracts":true}}}}}`
+	})
+
+	org, err := cl.GetOrganization(context.Background(), "org")
+	if err != nil {
+		t.Fatalf("GetOrganization returned error: %s", err)
+	}
+	if !org.Enterprise() || !org.CurrentPlan.Capabilities.Contracts {
+		t.Errorf("expected an enterprise organization with contracts, got %+v", org.CurrentPlan)
+	}
+}
+
+func TestGetMembershipByEmailIgnoresCase(t *testing.T) {
+	cl := testClient(t, func(t *testing.T, req graphqlRequest) (int, string) {
+		return http.StatusOK, `{"data":{"organization":{"memberships":[{"permission":"OBSERVER","user":{"id":"u1","name":"Ada","email":"Ada@example.com"}}]}}}`
+	})
+
+	member, err := cl.GetMembershipByEmail(context.Background(), "org", "ada@example.com")
+	if err != nil {
+		t.Fatalf("GetMembershipByEmail returned error: %s", err)
+	}
+	if member.User.ID != "u1" || member.Permission != "OBSERVER" {
+		t.Errorf("unexpected membership %+v", member)
+	}
+
+	if _, err := cl.GetMembershipByEmail(context.Background(), "org", "grace@example.com"); !IsNotFound(err) {
+		t.Errorf("expected not found for a non-member, got %v", err)
+	}
+}
+
+func TestParseGraphAccessRef(t *testing.T) {
+	graphId, subjectType, subjectId, err := ParseGraphAccessRef(GraphAccessRef("my-graph", AccessSubjectTeam, "team-1"))
+	if err != nil || graphId != "my-graph" || subjectType != AccessSubjectTeam || subjectId != "team-1" {
+		t.Errorf("ParseGraphAccessRef round trip = (%q, %q, %q, %v)", graphId, subjectType, subjectId, err)
+	}
+
+	for _, ref := range []string{"my-graph", "my-graph/user", "my-graph/group/1", "/user/1", "my-graph/user/"} {
+		if _, _, _, err := ParseGraphAccessRef(ref); err == nil {
+			t.Errorf("ParseGraphAccessRef(%q) returned no error", ref)
+		}
+	}
+}
+
+func TestCreateGraphSendsHiddenFlag(t *testing.T) {
+	cl := testClient(t, func(t *testing.T, req graphqlRequest) (int, string) {
+		if got := req.Variables["adminOnly"]; got != true {
+			t.Errorf("adminOnly variable = %v, want true", got)
+		}
+		return http.StatusOK, `{"data":{"newService":{"id":"graph-1","hiddenFromUninvitedNonAdminAccountMembers":true}}}`
+	})
+
+	graph, err := cl.CreateGraph(context.Background(), "org", "graph-1", "Graph", true)
+	if err != nil {
+		t.Fatalf("CreateGraph returned error: %s", err)
+	}
+	if !graph.HiddenFromUninvitedNonAdminAccountMembers {
+		t.Errorf("expected the created graph to be hidden")
+	}
+}
+
+func TestCreateNotificationChannelOmitsUnusedFields(t *testing.T) {
+	cl := testClient(t, func(t *testing.T, req graphqlRequest) (int, string) {
+		channel, ok := req.Variables["channel"].(map[string]interface{})
+		if !ok {
+			t.Fatalf("channel variable = %#v, want an object", req.Variables["channel"])
+		}
+		if channel["routingKey"] != "key" || channel["type"] != ChannelTypePagerDuty {
+			t.Errorf("unexpected channel input %v", channel)
+		}
+		if _, ok := channel["url"]; ok {
+			t.Errorf("url should be omitted for PagerDuty channels, got %v", channel["url"])
+		}
+		return http.StatusOK, `{"data":{"organization":{"createNotificationChannel":{"id":"ch-1","name":"on-call","type":"PAGERDUTY","url":""}}}}`
+	})
+
+	channel, err := cl.CreateNotificationChannel(context.Background(), "org", NotificationChannel{Name: "on-call", Type: ChannelTypePagerDuty, RoutingKey: "key"})
+	if err != nil {
+		t.Fatalf("CreateNotificationChannel returned error: %s", err)
+	}
+	if channel.ID != "ch-1" {
+		t.Errorf("unexpected channel %+v", channel)
+	}
+}
+
+func TestUpdateNotificationChannelClearsEmptyFields(t *testing.T) {
+	cl := testClient(t, func(t *testing.T, req graphqlRequest) (int, string) {
+		channel, ok := req.Variables["channel"].(map[string]interface{})
+		if !ok {
+			t.Fatalf("channel variable = %#v, want an object", req.Variables["channel"])
+		}
+		if secret, ok := channel["secretToken"]; !ok || secret != nil {
+			t.Errorf("secretToken should be sent as null, got %v (present: %t)", secret, ok)
+		}
+		if channel["url"] != "https://example.com/hook" {
+			t.Errorf("unexpected channel input %v", channel)
+		}
+		return http.StatusOK, `{"data":{"organization":{"updateNotificationChannel":{"id":"ch-1","name":"hook","type":"WEBHOOK","url":"https://example.com/hook"}}}}`
+	})
+
+	_, err := cl.UpdateNotificationChannel(context.Background(), "org", "ch-1", NotificationChannel{Name: "hook", Type: ChannelTypeWebhook, URL: "https://example.com/hook"})
+	if err != nil {
+		t.Fatalf("UpdateNotificationChannel returned error: %s", err)
+	}
+}
+
+func TestPublishPersistedOperations(t *testing.T) {
+	cl := testClient(t, func(t *testing.T, req graphqlRequest) (int, string) {
+		operations, ok := req.Variables["operations"].([]interface{})
+		if !ok || len(operations) != 1 {
+			t.Fatalf("operations variable = %#v, want one operation", req.Variables["operations"])
+		}
+		if got := operations[0].(map[string]interface{})["type"]; got != "QUERY" {
+			t.Errorf("operation type = %v, want QUERY", got)
+		}
+		if got := req.Variables["removeIds"].([]interface{}); len(got) != 1 || got[0] != "old" {
+			t.Errorf("removeIds variable = %v, want [old]", got)
+		}
+		return http.StatusOK, `{"data":{"service":{"persistedQueryList":{"publishOperations":{"errorMessages":["operation old is in use"]}}}}}`
+	})
+
+	err := cl.PublishPersistedOperations(context.Background(), "graph-1", "list-1",
+		[]PersistedOperation{{ID: "new", Name: "GetUser", Type: "query", Body: "query GetUser { user { id } }"}},
+		[]string{"old"},
+	)
+	if err == nil || err.Error() != "operation old is in use" {
+		t.Fatalf("expected publish error message, got %v", err)
+	}
+}
+
+func TestUpdateCheckConfigurationSendsEmptyLists(t *testing.T) {
+	cl := testClient(t, func(t *testing.T, req graphqlRequest) (int, string) {
+		if got := req.Variables["ref"]; got != "graph-1@current" {
+			t.Errorf("ref variable = %v, want graph-1@current", got)
+		}
+		configuration := req.Variables["configuration"].(map[string]interface{})
+		if got, ok := configuration["excludedClients"].([]interface{}); !ok || len(got) != 0 {
+			t.Errorf("excludedClients = %#v, want an empty list", configuration["excludedClients"])
+		}
+		return http.StatusOK, `{"data":{"variant":{"updateCheckConfiguration":{"timeRangeSeconds":604800,"operationCountThreshold":5,"excludedClients":[],"excludedOperationNames":[],"includedVariants":[],"lintFailsCheck":true,"proposalFailsCheck":false}}}}`
+	})
+
+	configuration, err := cl.UpdateCheckConfiguration(context.Background(), "graph-1", "current", CheckConfiguration{
+		TimeRangeSeconds:        604800,
+		OperationCountThreshold: 5,
+		ExcludedClients:         []string{},
+		LintFailsCheck:          true,
+	})
+	if err != nil {
+		t.Fatalf("UpdateCheckConfiguration returned error: %s", err)
+	}
+	if configuration.OperationCountThreshold != 5 || !configuration.LintFailsCheck {
+		t.Errorf("unexpected configuration %+v", configuration)
+	}
+}
+
+func TestUpdateLintConfigurationTranslatesLevels(t *testing.T) {
+	cl := testClient(t, func(t *testing.T, req graphqlRequest) (int, string) {
+		changes := req.Variables["changes"].(map[string]interface{})
+		rules := changes["rules"].([]interface{})
+		if got := rules[0].(map[string]interface{})["level"]; got != "WARNING" {
+			t.Errorf("rule level = %v, want WARNING", got)
+		}
+		return http.StatusOK, `{"data":{"service":{"updateLintConfiguration":{"rules":[{"rule":"RESTY_FIELD_NAMES","level":"WARNING"}],"ignoredTags":[],"allowedTagNames":[]}}}}`
+	})
+
+	configuration, err := cl.UpdateLintConfiguration(context.Background(), "graph-1", LintConfiguration{
+		Rules: []LintRuleLevel{{Rule: "RESTY_FIELD_NAMES", Level: "warn"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateLintConfiguration returned error: %s", err)
+	}
+	if got := configuration.RuleLevels()["RESTY_FIELD_NAMES"]; got != "warn" {
+		t.Errorf("RESTY_FIELD_NAMES level = %q, want warn", got)
+	}
+}
+
+func TestUpdateCustomCheckConfigurationKeepsSecret(t *testing.T) {
+	cl := testClient(t, func(t *testing.T, req graphqlRequest) (int, string) {
+		if secret, ok := req.Variables["secretToken"]; !ok || secret != nil {
+			t.Errorf("secretToken variable = %#v, want null", secret)
+		}
+		return http.StatusOK, `{"data":{"variant":{"updateCustomCheckConfiguration":{"enabled":false,"webhookUrl":"https://example.com/checks"}}}}`
+	})
+
+	configuration, err := cl.UpdateCustomCheckConfiguration(context.Background(), "graph-1", "current", false, "https://example.com/checks", nil)
+	if err != nil {
+		t.Fatalf("UpdateCustomCheckConfiguration returned error: %s", err)
+	}
+	if configuration.Enabled || configuration.WebhookURL != "https://example.com/checks" {
+		t.Errorf("unexpected configuration %+v", configuration)
+	}
+}
+
+func TestUpdateBuildPipelineTrackReportsBuildErrors(t *testing.T) {
+	cl := testClient(t, func(t *testing.T, req graphqlRequest) (int, string) {
+		if got := req.Variables["track"]; got != "FED_2_9" {
+			t.Errorf("track variable = %v, want FED_2_9", got)
+		}
+		return http.StatusOK, `{"data":{"variant":{"updateVariantFederationVersion":{"buildPipelineTrack":"FED_2_9","latestLaunch":{"id":"launch-1","status":"LAUNCH_FAILED","build":{"result":{"errorMessages":[{"message":"[products] Field \"id\" is not resolvable"}]}}}}}}}`
+	})
+
+	config, err := cl.UpdateBuildPipelineTrack(context.Background(), "graph-1", "current", "FED_2_9")
+	if err != nil {
+		t.Fatalf("UpdateBuildPipelineTrack returned error: %s", err)
+	}
+	if got := config.LatestLaunch.BuildErrors(); len(got) != 1 || got[0] != `[products] Field "id" is not resolvable` {
+		t.Errorf("build errors = %v", got)
+	}
+}
+
+func TestCreateCloudRouterReturnsErrorMessages(t *testing.T) {
+	cl := testClient(t, func(t *testing.T, req graphqlRequest) (int, string) {
+		input := req.Variables["input"].(map[string]interface{})
+		if _, ok := input["version"]; ok {
+			t.Errorf("input = %v, want no version", input)
+		}
+		return http.StatusOK, `{"data":{"variant":{"createRouter":{"router":null,"errorMessages":["variant has no supergraph"]}}}}`
+	})
+
+	_, err := cl.CreateCloudRouter(context.Background(), "graph-1", "current", CloudRouterInput{RouterConfig: "{}\n"})
+	if err == nil || err.Error() != "variant has no supergraph" {
+		t.Fatalf("expected create error message, got %v", err)
+	}
+}
+
+func TestSetRouterSecretsSortsByName(t *testing.T) {
+	cl := testClient(t, func(t *testing.T, req graphqlRequest) (int, string) {
+		secrets := req.Variables["secrets"].([]interface{})
+		if len(secrets) != 2 || secrets[0].(map[string]interface{})["name"] != "A_TOKEN" || secrets[1].(map[string]interface{})["name"] != "B_TOKEN" {
+			t.Errorf("secrets variable = %v, want A_TOKEN then B_TOKEN", secrets)
+		}
+		return http.StatusOK, `{"data":{"variant":{"setRouterSecrets":{"errorMessages":[]}}}}`
+	})
+
+	err := cl.SetRouterSecrets(context.Background(), "graph-1", "current", map[string]string{"B_TOKEN": "b", "A_TOKEN": "a"})
+	if err != nil {
+		t.Fatalf("SetRouterSecrets returned error: %s", err)
+	}
+}
@@ -0,0 +1,284 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+)
+
+// CloudRouter is the Apollo-managed router serving a variant.
+type CloudRouter struct {
+	Status       string  `json:"status"`
+	RouterURL    string  `json:"routerUrl"`
+	RouterConfig string  `json:"routerConfig"`
+	Version      string  `json:"version"`
+	CustomDomain *string `json:"customDomain"`
+	Secrets      []struct {
+		Name string `json:"name"`
+	} `json:"secrets"`
+}
+
+// SecretNames returns the names of the secrets set on the router.
+func (r *CloudRouter) SecretNames() []string {
+	names := make([]string, 0, len(r.Secrets))
+	for _, secret := range r.Secrets {
+		names = append(names, secret.Name)
+	}
+	return names
+}
+
+// CloudRouterInput is the configuration of a managed router. A nil Version
+// lets Apollo pick the default channel.
+type CloudRouterInput struct {
+	RouterConfig string  `json:"routerConfig"`
+	Version      *string `json:"version,omitempty"`
+	CustomDomain *string `json:"customDomain"`
+}
+
+const cloudRouterFields = `
+      status
+      routerUrl
+      routerConfig
+      version
+      customDomain
+      secrets {
+        name
+      }`
+
+var getCloudRouterOperation = Operation{
+	Name: "GetCloudRouter",
+	Query: `query GetCloudRouter($graphId: ID!, $name: String!) {
+  service(id: $graphId) {
+    variant(name: $name) {
+      router {` + cloudRouterFields + `
+      }
+    }
+  }
+}`,
+}
+
+var createCloudRouterOperation = Operation{
+	Name: "CreateCloudRouter",
+	Query: `mutation CreateCloudRouter($ref: ID!, $input: CloudRouterInput!) {
+  variant(ref: $ref) {
+    createRouter(input: $input) {
+      router {` + cloudRouterFields + `
+      }
+      errorMessages
+    }
+  }
+}`,
+}
+
+var updateCloudRouterOperation = Operation{
+	Name: "UpdateCloudRouter",
+	Query: `mutation UpdateCloudRouter($ref: ID!, $input: CloudRouterInput!) {
+  variant(ref: $ref) {
+    updateRouter(input: $input) {
+      router {` + cloudRouterFields + `
+      }
+      errorMessages
+    }
+  }
+}`,
+}
+
+var destroyCloudRouterOperation = Operation{
+	Name: "DestroyCloudRouter",
+	Query: `mutation DestroyCloudRouter($ref: ID!) {
+  variant(ref: $ref) {
+    destroyRouter {
+      errorMessages
+    }
+  }
+}`,
+}
+
+var setRouterSecretsOperation = Operation{
+	Name: "SetRouterSecrets",
+	Query: `mutation SetRouterSecrets($ref: ID!, $secrets: [RouterSecretInput!]!) {
+  variant(ref: $ref) {
+    setRouterSecrets(secrets: $secrets) {
+      errorMessages
+    }
+  }
+}`,
+}
+
+var removeRouterSecretsOperation = Operation{
+	Name: "RemoveRouterSecrets",
+	Query: `mutation RemoveRouterSecrets($ref: ID!, $names: [String!]!) {
+  variant(ref: $ref) {
+    removeRouterSecrets(names: $names) {
+      errorMessages
+    }
+  }
+}`,
+}
+
+// routerResult is the payload of router mutations.
+type routerResult struct {
+	Router        *CloudRouter `json:"router"`
+	ErrorMessages []string     `json:"errorMessages"`
+}
+
+func (r *routerResult) err() error {
+	if r == nil {
+		return ErrNotFound
+	}
+	if len(r.ErrorMessages) > 0 {
+		return errors.New(strings.Join(r.ErrorMessages, "; "))
+	}
+	return nil
+}
+
+// GetCloudRouter returns the managed router of the variant name of graphId,
+// or ErrNotFound if the variant does not exist or is not served by a managed
+// router.
+func (cl *Client) GetCloudRouter(ctx context.Context, graphId, name string) (*CloudRouter, error) {
+	var response struct {
+		Service *struct {
+			Variant *struct {
+				Router *CloudRouter `json:"router"`
+			} `json:"variant"`
+		} `json:"service"`
+	}
+
+	err := cl.Query(ctx, getCloudRouterOperation, Variables{"graphId": graphId, "name": name}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Service == nil || response.Service.Variant == nil || response.Service.Variant.Router == nil {
+		return nil, ErrNotFound
+	}
+
+	return response.Service.Variant.Router, nil
+}
+
+// CreateCloudRouter provisions a managed router for the variant name of
+// graphId. Provisioning continues after the call returns; poll the router
+// status to follow it.
+func (cl *Client) CreateCloudRouter(ctx context.Context, graphId, name string, input CloudRouterInput) (*CloudRouter, error) {
+	var response struct {
+		Variant *struct {
+			CreateRouter *routerResult `json:"createRouter"`
+		} `json:"variant"`
+	}
+
+	err := cl.Query(ctx, createCloudRouterOperation, Variables{"ref": VariantRef(graphId, name), "input": input}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Variant == nil {
+		return nil, ErrNotFound
+	}
+	if err := response.Variant.CreateRouter.err(); err != nil {
+		return nil, err
+	}
+	if response.Variant.CreateRouter.Router == nil {
+		return nil, ErrNotFound
+	}
+
+	return response.Variant.CreateRouter.Router, nil
+}
+
+// UpdateCloudRouter replaces the configuration of the managed router of the
+// variant name of graphId.
+func (cl *Client) UpdateCloudRouter(ctx context.Context, graphId, name string, input CloudRouterInput) (*CloudRouter, error) {
+	var response struct {
+		Variant *struct {
+			UpdateRouter *routerResult `json:"updateRouter"`
+		} `json:"variant"`
+	}
+
+	err := cl.Query(ctx, updateCloudRouterOperation, Variables{"ref": VariantRef(graphId, name), "input": input}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Variant == nil {
+		return nil, ErrNotFound
+	}
+	if err := response.Variant.UpdateRouter.err(); err != nil {
+		return nil, err
+	}
+	if response.Variant.UpdateRouter.Router == nil {
+		return nil, ErrNotFound
+	}
+
+	return response.Variant.UpdateRouter.Router, nil
+}
+
+// DestroyCloudRouter deprovisions the managed router of the variant name of
+// graphId.
+func (cl *Client) DestroyCloudRouter(ctx context.Context, graphId, name string) error {
+	var response struct {
+		Variant *struct {
+			DestroyRouter *routerResult `json:"destroyRouter"`
+		} `json:"variant"`
+	}
+
+	err := cl.Query(ctx, destroyCloudRouterOperation, Variables{"ref": VariantRef(graphId, name)}, &response)
+	if err != nil {
+		return err
+	}
+	if response.Variant == nil {
+		return ErrNotFound
+	}
+
+	return response.Variant.DestroyRouter.err()
+}
+
+// SetRouterSecrets sets secrets, keyed by name, on the managed router of the
+// variant name of graphId. The router exposes each secret as an environment
+// variable of the same name. Existing secrets with the same names are
+// replaced.
+func (cl *Client) SetRouterSecrets(ctx context.Context, graphId, name string, secrets map[string]string) error {
+	var response struct {
+		Variant *struct {
+			SetRouterSecrets *routerResult `json:"setRouterSecrets"`
+		} `json:"variant"`
+	}
+
+	// Sort the secrets so that requests are stable across runs.
+	names := make([]string, 0, len(secrets))
+	for secretName := range secrets {
+		names = append(names, secretName)
+	}
+	sort.Strings(names)
+
+	input := make([]map[string]string, 0, len(names))
+	for _, secretName := range names {
+		input = append(input, map[string]string{"name": secretName, "value": secrets[secretName]})
+	}
+
+	err := cl.Query(ctx, setRouterSecretsOperation, Variables{"ref": VariantRef(graphId, name), "secrets": input}, &response)
+	if err != nil {
+		return err
+	}
+	if response.Variant == nil {
+		return ErrNotFound
+	}
+
+	return response.Variant.SetRouterSecrets.err()
+}
+
+// RemoveRouterSecrets removes the secrets with the given names from the
+// managed router of the variant name of graphId.
+func (cl *Client) RemoveRouterSecrets(ctx context.Context, graphId, name string, names []string) error {
+	var response struct {
+		Variant *struct {
+			RemoveRouterSecrets *routerResult `json:"removeRouterSecrets"`
+		} `json:"variant"`
+	}
+
+	err := cl.Query(ctx, removeRouterSecretsOperation, Variables{"ref": VariantRef(graphId, name), "names": names}, &response)
+	if err != nil {
+		return err
+	}
+	if response.Variant == nil {
+		return ErrNotFound
+	}
+
+	return response.Variant.RemoveRouterSecrets.err()
+}
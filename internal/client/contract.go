@@ -0,0 +1,171 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// ContractFilter selects the parts of the source variant's schema that are
+// included in a contract variant.
+type ContractFilter struct {
+	Include              []string `json:"include"`
+	Exclude              []string `json:"exclude"`
+	HideUnreachableTypes bool     `json:"hideUnreachableTypes"`
+}
+
+// Launch is a composition and build run of a variant.
+type Launch struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+	Build  *struct {
+		Result *struct {
+			ErrorMessages []struct {
+				Message string `json:"message"`
+			} `json:"errorMessages"`
+		} `json:"result"`
+	} `json:"build"`
+}
+
+// BuildErrors returns the messages of the errors that failed the launch build.
+func (l *Launch) BuildErrors() []string {
+	if l == nil || l.Build == nil || l.Build.Result == nil {
+		return nil
+	}
+
+	messages := make([]string, 0, len(l.Build.Result.ErrorMessages))
+	for _, e := range l.Build.Result.ErrorMessages {
+		messages = append(messages, e.Message)
+	}
+	return messages
+}
+
+// ContractVariant is a variant whose schema is derived from a source variant
+// by filtering on @tag names.
+type ContractVariant struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	SourceVariant *struct {
+		Name string `json:"name"`
+	} `json:"sourceVariant"`
+	ContractFilterConfig *ContractFilter `json:"contractFilterConfig"`
+	LatestLaunch         *Launch         `json:"latestLaunch"`
+}
+
+const launchFields = `
+        id
+        status
+        build {
+          result {
+            ... on BuildFailure {
+              errorMessages {
+                message
+              }
+            }
+          }
+        }`
+
+var getContractVariantOperation = Operation{
+	Name: "GetContractVariant",
+	Query: `query GetContractVariant($graphId: ID!, $name: String!) {
+  service(id: $graphId) {
+    variant(name: $name) {
+      id
+      name
+      sourceVariant {
+        name
+      }
+      contractFilterConfig {
+        include
+        exclude
+        hideUnreachableTypes
+      }
+      latestLaunch {` + launchFields + `
+      }
+    }
+  }
+}`,
+}
+
+var upsertContractVariantOperation = Operation{
+	Name: "UpsertContractVariant",
+	Query: `mutation UpsertContractVariant($graphId: ID!, $name: String!, $sourceVariant: String!, $filterConfig: FilterConfigInput!) {
+  service(id: $graphId) {
+    upsertContractVariant(contractVariantName: $name, sourceVariant: $sourceVariant, filterConfig: $filterConfig, initiateLaunch: true) {
+      ... on ContractVariantUpsertSuccess {
+        contractVariant {
+          id
+          name
+        }
+      }
+      ... on ContractVariantUpsertErrors {
+        errorMessages
+      }
+    }
+  }
+}`,
+}
+
+// GetContractVariant returns the contract variant name of the graph graphId,
+// or ErrNotFound if it does not exist or is not a contract.
+func (cl *Client) GetContractVariant(ctx context.Context, graphId, name string) (*ContractVariant, error) {
+	var response struct {
+		Service *struct {
+			Variant *ContractVariant `json:"variant"`
+		} `json:"service"`
+	}
+
+	err := cl.Query(ctx, getContractVariantOperation, Variables{
+		"graphId": graphId,
+		"name":    name,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Service == nil || response.Service.Variant == nil || response.Service.Variant.ContractFilterConfig == nil {
+		return nil, ErrNotFound
+	}
+
+	return response.Service.Variant, nil
+}
+
+// UpsertContractVariant creates or updates the contract variant name of the
+// graph graphId and launches a build of its schema.
+func (cl *Client) UpsertContractVariant(ctx context.Context, graphId, name, sourceVariant string, filter ContractFilter) error {
+	var response struct {
+		Service *struct {
+			UpsertContractVariant *struct {
+				ContractVariant *struct {
+					ID string `json:"id"`
+				} `json:"contractVariant"`
+				ErrorMessages []string `json:"errorMessages"`
+			} `json:"upsertContractVariant"`
+		} `json:"service"`
+	}
+
+	if filter.Include == nil {
+		filter.Include = []string{}
+	}
+	if filter.Exclude == nil {
+		filter.Exclude = []string{}
+	}
+
+	err := cl.Query(ctx, upsertContractVariantOperation, Variables{
+		"graphId":       graphId,
+		"name":          name,
+		"sourceVariant": VariantRef(graphId, sourceVariant),
+		"filterConfig":  filter,
+	}, &response)
+	if err != nil {
+		return err
+	}
+	if response.Service == nil || response.Service.UpsertContractVariant == nil {
+		return ErrNotFound
+	}
+
+	if messages := response.Service.UpsertContractVariant.ErrorMessages; len(messages) > 0 {
+		return errors.New(strings.Join(messages, "; "))
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package client
+
+import "context"
+
+// CustomCheckConfiguration is the webhook Apollo calls during schema checks
+// of a variant. The secret used to sign requests is never returned.
+type CustomCheckConfiguration struct {
+	Enabled    bool   `json:"enabled"`
+	WebhookURL string `json:"webhookUrl"`
+}
+
+const customCheckConfigurationFields = `
+      enabled
+      webhookUrl`
+
+var getCustomCheckConfigurationOperation = Operation{
+	Name: "GetCustomCheckConfiguration",
+	Query: `query GetCustomCheckConfiguration($graphId: ID!, $name: String!) {
+  service(id: $graphId) {
+    variant(name: $name) {
+      customCheckConfiguration {` + customCheckConfigurationFields + `
+      }
+    }
+  }
+}`,
+}
+
+var updateCustomCheckConfigurationOperation = Operation{
+	Name: "UpdateCustomCheckConfiguration",
+	Query: `mutation UpdateCustomCheckConfiguration($ref: ID!, $enabled: Boolean!, $webhookUrl: String!, $secretToken: String) {
+  variant(ref: $ref) {
+    updateCustomCheckConfiguration(enableCustomChecks: $enabled, webhookUrl: $webhookUrl, secretToken: $secretToken) {` + customCheckConfigurationFields + `
+    }
+  }
+}`,
+}
+
+var deleteCustomCheckConfigurationOperation = Operation{
+	Name: "DeleteCustomCheckConfiguration",
+	Query: `mutation DeleteCustomCheckConfiguration($ref: ID!) {
+  variant(ref: $ref) {
+    deleteCustomCheckConfiguration
+  }
+}`,
+}
+
+// GetCustomCheckConfiguration returns the custom check webhook of the variant
+// name of graphId, or ErrNotFound if the variant does not exist or has no
+// webhook.
+func (cl *Client) GetCustomCheckConfiguration(ctx context.Context, graphId, name string) (*CustomCheckConfiguration, error) {
+	var response struct {
+		Service *struct {
+			Variant *struct {
+				CustomCheckConfiguration *CustomCheckConfiguration `json:"customCheckConfiguration"`
+			} `json:"variant"`
+		} `json:"service"`
+	}
+
+	err := cl.Query(ctx, getCustomCheckConfigurationOperation, Variables{"graphId": graphId, "name": name}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Service == nil || response.Service.Variant == nil || response.Service.Variant.CustomCheckConfiguration == nil {
+		return nil, ErrNotFound
+	}
+
+	return response.Service.Variant.CustomCheckConfiguration, nil
+}
+
+// UpdateCustomCheckConfiguration registers the custom check webhook of the
+// variant name of graphId. A nil secretToken keeps the current secret.
+func (cl *Client) UpdateCustomCheckConfiguration(ctx context.Context, graphId, name string, enabled bool, webhookUrl string, secretToken *string) (*CustomCheckConfiguration, error) {
+	var response struct {
+		Variant *struct {
+			UpdateCustomCheckConfiguration *CustomCheckConfiguration `json:"updateCustomCheckConfiguration"`
+		} `json:"variant"`
+	}
+
+	err := cl.Query(ctx, updateCustomCheckConfigurationOperation, Variables{
+		"ref":         VariantRef(graphId, name),
+		"enabled":     enabled,
+		"webhookUrl":  webhookUrl,
+		"secretToken": secretToken,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Variant == nil || response.Variant.UpdateCustomCheckConfiguration == nil {
+		return nil, ErrNotFound
+	}
+
+	return response.Variant.UpdateCustomCheckConfiguration, nil
+}
+
+// DeleteCustomCheckConfiguration removes the custom check webhook of the
+// variant name of graphId, which also disables custom checks.
+func (cl *Client) DeleteCustomCheckConfiguration(ctx context.Context, graphId, name string) error {
+	var response struct {
+		Variant *struct {
+			DeleteCustomCheckConfiguration interface{} `json:"deleteCustomCheckConfiguration"`
+		} `json:"variant"`
+	}
+
+	err := cl.Query(ctx, deleteCustomCheckConfigurationOperation, Variables{"ref": VariantRef(graphId, name)}, &response)
+	if err != nil {
+		return err
+	}
+	if response.Variant == nil {
+		return ErrNotFound
+	}
+
+	return nil
+}
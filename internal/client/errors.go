@@ -0,0 +1,108 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrNotFound is returned by typed operations when the requested object does
+// not exist or is not visible to the configured API key.
+var ErrNotFound = errors.New("not found")
+
+// Error is a single entry of the "errors" member of a GraphQL response.
+type Error struct {
+	Message    string                 `json:"message"`
+	Path       []interface{}          `json:"path,omitempty"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+}
+
+// Code returns the extensions.code of the error, if any.
+func (e Error) Code() string {
+	code, _ := e.Extensions["code"].(string)
+	return code
+}
+
+func (e Error) Error() string {
+	if code := e.Code(); code != "" {
+		return fmt.Sprintf("%s (%s)", e.Message, code)
+	}
+	return e.Message
+}
+
+// Errors is the list of errors returned in a GraphQL response.
+type Errors []Error
+
+func (e Errors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+	return "graphql: " + strings.Join(messages, "; ")
+}
+
+// HasCode reports whether any of the errors carries the given extensions.code.
+func (e Errors) HasCode(code string) bool {
+	for _, err := range e {
+		if err.Code() == code {
+			return true
+		}
+	}
+	return false
+}
+
+// HTTPError is returned when the API answers with a non-2xx status and no
+// GraphQL errors.
+type HTTPError struct {
+	StatusCode int
+	Body       string
+}
+
+// maxErrorBodyLength bounds how much of an unexpected response body is
+// included in error messages.
+const maxErrorBodyLength = 512
+
+func (e *HTTPError) Error() string {
+	body := strings.TrimSpace(e.Body)
+	if len(body) > maxErrorBodyLength {
+		body = body[:maxErrorBodyLength] + "..."
+	}
+	return fmt.Sprintf("unexpected HTTP status %d: %s", e.StatusCode, body)
+}
+
+// IsConflict reports whether err means the object being created already exists.
+func IsConflict(err error) bool {
+	var gqlErrors Errors
+	if !errors.As(err, &gqlErrors) {
+		return false
+	}
+
+	if gqlErrors.HasCode("CONFLICT") {
+		return true
+	}
+	for _, e := range gqlErrors {
+		if strings.Contains(strings.ToLower(e.Message), "already exists") {
+			return true
+		}
+	}
+	return false
+}
+
+// IsNotFound reports whether err means the requested object does not exist.
+func IsNotFound(err error) bool {
+	if errors.Is(err, ErrNotFound) {
+		return true
+	}
+
+	var gqlErrors Errors
+	if errors.As(err, &gqlErrors) {
+		return gqlErrors.HasCode("NOT_FOUND")
+	}
+
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode == 404
+	}
+
+	return false
+}
@@ -0,0 +1,243 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Graph is an Apollo graph (a "service" in the Platform API).
+type Graph struct {
+	ID                                        string   `json:"id"`
+	Name                                      string   `json:"name"`
+	Title                                     string   `json:"title"`
+	Account                                   *Account `json:"account"`
+	HiddenFromUninvitedNonAdminAccountMembers bool     `json:"hiddenFromUninvitedNonAdminAccountMembers"`
+	Variants                                  []struct {
+		Name string `json:"name"`
+	} `json:"variants"`
+}
+
+// Account is the Apollo organization that owns a graph.
+type Account struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+var createGraphOperation = Operation{
+	Name: "CreateGraph",
+	Query: `mutation CreateGraph($orgId: ID!, $id: ID!, $name: String!, $adminOnly: Boolean!) {
+  newService(accountId: $orgId, id: $id, name: $name, hiddenFromUninvitedNonAdminAccountMembers: $adminOnly) {
+    id
+    name
+    title
+    account {
+      id
+      name
+    }
+    hiddenFromUninvitedNonAdminAccountMembers
+  }
+}`,
+}
+
+var getGraphOperation = Operation{
+	Name: "GetGraph",
+	Query: `query GetGraph($id: ID!) {
+  service(id: $id) {
+    id
+    name
+    title
+    account {
+      id
+      name
+    }
+    hiddenFromUninvitedNonAdminAccountMembers
+    variants {
+      name
+    }
+  }
+}`,
+}
+
+var updateGraphTitleOperation = Operation{
+	Name: "UpdateGraphTitle",
+	Query: `mutation UpdateGraphTitle($id: ID!, $title: String!) {
+  service(id: $id) {
+    updateTitle(title: $title) {
+      id
+      name
+      title
+      account {
+        id
+        name
+      }
+      hiddenFromUninvitedNonAdminAccountMembers
+    }
+  }
+}`,
+}
+
+var updateGraphHiddenOperation = Operation{
+	Name: "UpdateGraphHidden",
+	Query: `mutation UpdateGraphHidden($id: ID!, $hidden: Boolean!) {
+  service(id: $id) {
+    updateHiddenFromUninvitedNonAdminAccountMembers(hiddenFromUninvitedNonAdminAccountMembers: $hidden) {
+      id
+      name
+      title
+      account {
+        id
+        name
+      }
+      hiddenFromUninvitedNonAdminAccountMembers
+    }
+  }
+}`,
+}
+
+var deleteGraphOperation = Operation{
+	Name: "DeleteGraph",
+	Query: `mutation DeleteGraph($id: ID!) {
+  service(id: $id) {
+    delete
+  }
+}`,
+}
+
+// CreateGraph creates a graph with the given ID and name in the organization orgId.
+func (cl *Client) CreateGraph(ctx context.Context, orgId, id, name string, adminOnly bool) (*Graph, error) {
+	var response struct {
+		NewService *Graph `json:"newService"`
+	}
+
+	err := cl.Query(ctx, createGraphOperation, Variables{
+		"orgId":     orgId,
+		"id":        id,
+		"name":      name,
+		"adminOnly": adminOnly,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.NewService == nil {
+		return nil, ErrNotFound
+	}
+
+	return response.NewService, nil
+}
+
+// GetGraph returns the graph with the given ID, or ErrNotFound if it does not
+// exist.
+func (cl *Client) GetGraph(ctx context.Context, id string) (*Graph, error) {
+	var response struct {
+		Service *Graph `json:"service"`
+	}
+
+	err := cl.Query(ctx, getGraphOperation, Variables{"id": id}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Service == nil {
+		return nil, ErrNotFound
+	}
+
+	return response.Service, nil
+}
+
+// FindGraphByTitle returns the graph of the organization orgId whose title is
+// title. It returns ErrNotFound if there is none, and an error if the title is
+// ambiguous.
+func (cl *Client) FindGraphByTitle(ctx context.Context, orgId, title string) (*Graph, error) {
+	graphs, err := cl.ListGraphs(ctx, orgId)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []string
+	for _, graph := range graphs {
+		if graph.Title == title {
+			matches = append(matches, graph.ID)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, ErrNotFound
+	case 1:
+		return cl.GetGraph(ctx, matches[0])
+	default:
+		return nil, fmt.Errorf("%d graphs in organization %s are named %q: %s", len(matches), orgId, title, strings.Join(matches, ", "))
+	}
+}
+
+// DisplayName returns the title of the graph, falling back to its name.
+func (g *Graph) DisplayName() string {
+	if g.Title != "" {
+		return g.Title
+	}
+	return g.Name
+}
+
+// UpdateGraphTitle changes the display name of the graph with the given ID.
+func (cl *Client) UpdateGraphTitle(ctx context.Context, id, title string) (*Graph, error) {
+	var response struct {
+		Service *struct {
+			UpdateTitle *Graph `json:"updateTitle"`
+		} `json:"service"`
+	}
+
+	err := cl.Query(ctx, updateGraphTitleOperation, Variables{
+		"id":    id,
+		"title": title,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Service == nil || response.Service.UpdateTitle == nil {
+		return nil, ErrNotFound
+	}
+
+	return response.Service.UpdateTitle, nil
+}
+
+// UpdateGraphHidden changes whether the graph with the given ID is hidden from
+// organization members who are neither admins nor invited to it.
+func (cl *Client) UpdateGraphHidden(ctx context.Context, id string, hidden bool) (*Graph, error) {
+	var response struct {
+		Service *struct {
+			UpdateHidden *Graph `json:"updateHiddenFromUninvitedNonAdminAccountMembers"`
+		} `json:"service"`
+	}
+
+	err := cl.Query(ctx, updateGraphHiddenOperation, Variables{
+		"id":     id,
+		"hidden": hidden,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Service == nil || response.Service.UpdateHidden == nil {
+		return nil, ErrNotFound
+	}
+
+	return response.Service.UpdateHidden, nil
+}
+
+// DeleteGraph deletes the graph with the given ID.
+func (cl *Client) DeleteGraph(ctx context.Context, id string) error {
+	var response struct {
+		Service *struct {
+			Delete interface{} `json:"delete"`
+		} `json:"service"`
+	}
+
+	err := cl.Query(ctx, deleteGraphOperation, Variables{"id": id}, &response)
+	if err != nil {
+		return err
+	}
+	if response.Service == nil {
+		return ErrNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,191 @@
+package client
+
+import (
+	"context"
+	"strings"
+)
+
+// LintRules are the GraphOS schema linter rules that can be configured, grouped
+// by naming conventions, descriptions, deprecation, @tag usage and other
+// schema hygiene rules.
+var LintRules = []string{
+	"FIELD_NAMES_SHOULD_BE_CAMEL_CASE",
+	"INPUT_ARGUMENT_NAMES_SHOULD_BE_CAMEL_CASE",
+	"TYPE_NAMES_SHOULD_BE_PASCAL_CASE",
+	"ENUM_VALUES_SHOULD_BE_SCREAMING_SNAKE_CASE",
+	"INPUT_TYPE_SUFFIX",
+	"TYPE_PREFIX",
+	"TYPE_SUFFIX",
+	"OBJECT_PREFIX",
+	"OBJECT_SUFFIX",
+	"INTERFACE_PREFIX",
+	"INTERFACE_SUFFIX",
+	"ENUM_PREFIX",
+	"ENUM_SUFFIX",
+	"RESTY_FIELD_NAMES",
+
+	"ALL_ELEMENTS_REQUIRE_DESCRIPTION",
+	"DESCRIPTION_DUPLICATION",
+
+	"DEPRECATED_DIRECTIVE_WITHOUT_REASON",
+	"NULLABLE_PATH_VARIABLE",
+
+	"TAG_DIRECTIVE_USES_UNKNOWN_NAME",
+	"CONTACT_DIRECTIVE_MISSING",
+
+	"DEFINED_TYPES_ARE_UNUSED",
+	"QUERY_DOCUMENT_DECLARATION",
+}
+
+// LintLevels are the levels a linter rule can be set to. Apollo spells warn as
+// WARNING; the client translates between the two.
+var LintLevels = []string{"error", "warn", "ignore"}
+
+// LintRuleLevel is the level of a single linter rule.
+type LintRuleLevel struct {
+	Rule  string `json:"rule"`
+	Level string `json:"level"`
+}
+
+// LintConfiguration is the schema linter configuration of a graph.
+type LintConfiguration struct {
+	Rules []LintRuleLevel `json:"rules"`
+	// IgnoredTags are the @tag names that mark schema elements the linter
+	// skips.
+	IgnoredTags []string `json:"ignoredTags"`
+	// AllowedTagNames are the only @tag names TAG_DIRECTIVE_USES_UNKNOWN_NAME
+	// accepts.
+	AllowedTagNames []string `json:"allowedTagNames"`
+}
+
+// RuleLevels returns the level of each rule, keyed by rule name.
+func (c *LintConfiguration) RuleLevels() map[string]string {
+	levels := make(map[string]string, len(c.Rules))
+	for _, rule := range c.Rules {
+		levels[rule.Rule] = rule.Level
+	}
+	return levels
+}
+
+const lintConfigurationFields = `
+      rules {
+        rule
+        level
+      }
+      ignoredTags
+      allowedTagNames`
+
+var getLintConfigurationOperation = Operation{
+	Name: "GetLintConfiguration",
+	Query: `query GetLintConfiguration($graphId: ID!) {
+  service(id: $graphId) {
+    lintConfiguration {` + lintConfigurationFields + `
+    }
+  }
+}`,
+}
+
+var updateLintConfigurationOperation = Operation{
+	Name: "UpdateLintConfiguration",
+	Query: `mutation UpdateLintConfiguration($graphId: ID!, $changes: LintConfigurationInput!) {
+  service(id: $graphId) {
+    updateLintConfiguration(changes: $changes) {` + lintConfigurationFields + `
+    }
+  }
+}`,
+}
+
+var resetLintConfigurationOperation = Operation{
+	Name: "ResetLintConfiguration",
+	Query: `mutation ResetLintConfiguration($graphId: ID!) {
+  service(id: $graphId) {
+    resetLintConfiguration {` + lintConfigurationFields + `
+    }
+  }
+}`,
+}
+
+// GetLintConfiguration returns the linter configuration of graphId, or
+// ErrNotFound if the graph does not exist.
+func (cl *Client) GetLintConfiguration(ctx context.Context, graphId string) (*LintConfiguration, error) {
+	var response struct {
+		Service *struct {
+			LintConfiguration *LintConfiguration `json:"lintConfiguration"`
+		} `json:"service"`
+	}
+
+	err := cl.Query(ctx, getLintConfigurationOperation, Variables{"graphId": graphId}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Service == nil || response.Service.LintConfiguration == nil {
+		return nil, ErrNotFound
+	}
+
+	return fromAPILintConfiguration(response.Service.LintConfiguration), nil
+}
+
+// UpdateLintConfiguration sets the level of the rules in configuration and
+// replaces the ignored and allowed tags of graphId. Rules not listed keep
+// their current level.
+func (cl *Client) UpdateLintConfiguration(ctx context.Context, graphId string, configuration LintConfiguration) (*LintConfiguration, error) {
+	var response struct {
+		Service *struct {
+			UpdateLintConfiguration *LintConfiguration `json:"updateLintConfiguration"`
+		} `json:"service"`
+	}
+
+	rules := make([]LintRuleLevel, 0, len(configuration.Rules))
+	for _, rule := range configuration.Rules {
+		rules = append(rules, LintRuleLevel{Rule: rule.Rule, Level: toAPILintLevel(rule.Level)})
+	}
+	configuration.Rules = rules
+
+	err := cl.Query(ctx, updateLintConfigurationOperation, Variables{"graphId": graphId, "changes": configuration}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Service == nil || response.Service.UpdateLintConfiguration == nil {
+		return nil, ErrNotFound
+	}
+
+	return fromAPILintConfiguration(response.Service.UpdateLintConfiguration), nil
+}
+
+// ResetLintConfiguration restores the default linter configuration of
+// graphId.
+func (cl *Client) ResetLintConfiguration(ctx context.Context, graphId string) error {
+	var response struct {
+		Service *struct {
+			ResetLintConfiguration *LintConfiguration `json:"resetLintConfiguration"`
+		} `json:"service"`
+	}
+
+	err := cl.Query(ctx, resetLintConfigurationOperation, Variables{"graphId": graphId}, &response)
+	if err != nil {
+		return err
+	}
+	if response.Service == nil {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func toAPILintLevel(level string) string {
+	if level == "warn" {
+		return "WARNING"
+	}
+	return strings.ToUpper(level)
+}
+
+func fromAPILintConfiguration(configuration *LintConfiguration) *LintConfiguration {
+	for i, rule := range configuration.Rules {
+		level := strings.ToLower(rule.Level)
+		if level == "warning" {
+			level = "warn"
+		}
+		configuration.Rules[i].Level = level
+	}
+	return configuration
+}
@@ -0,0 +1,289 @@
+package client
+
+import (
+	"context"
+	"strings"
+)
+
+// OrgRoles are the roles a member of an organization can have.
+var OrgRoles = []string{
+	"ORG_ADMIN",
+	"GRAPH_ADMIN",
+	"CONTRIBUTOR",
+	"DOCUMENTER",
+	"OBSERVER",
+	"CONSUMER",
+}
+
+// User is an Apollo Studio user.
+type User struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// Membership is a user's membership of an organization.
+type Membership struct {
+	User       User   `json:"user"`
+	Permission string `json:"permission"`
+}
+
+// Invitation is a pending or accepted invitation to join an organization.
+type Invitation struct {
+	ID         string  `json:"id"`
+	Email      string  `json:"email"`
+	Role       string  `json:"role"`
+	CreatedAt  string  `json:"createdAt"`
+	AcceptedAt *string `json:"acceptedAt"`
+}
+
+var listMembershipsOperation = Operation{
+	Name: "ListMemberships",
+	Query: `query ListMemberships($orgId: ID!) {
+  organization(id: $orgId) {
+    memberships {
+      permission
+      user {
+        id
+        name
+        email
+      }
+    }
+  }
+}`,
+}
+
+var updateMemberRoleOperation = Operation{
+	Name: "UpdateMemberRole",
+	Query: `mutation UpdateMemberRole($orgId: ID!, $userId: ID!, $role: UserPermission!) {
+  organization(id: $orgId) {
+    updateUserPermission(userID: $userId, permission: $role) {
+      permission
+      user {
+        id
+        name
+        email
+      }
+    }
+  }
+}`,
+}
+
+var removeMemberOperation = Operation{
+	Name: "RemoveMember",
+	Query: `mutation RemoveMember($orgId: ID!, $userId: ID!) {
+  organization(id: $orgId) {
+    removeMember(id: $userId) {
+      id
+    }
+  }
+}`,
+}
+
+var listInvitationsOperation = Operation{
+	Name: "ListInvitations",
+	Query: `query ListInvitations($orgId: ID!) {
+  organization(id: $orgId) {
+    invitations(includeAccepted: true) {
+      id
+      email
+      role
+      createdAt
+      acceptedAt
+    }
+  }
+}`,
+}
+
+var inviteOperation = Operation{
+	Name: "Invite",
+	Query: `mutation Invite($orgId: ID!, $email: String!, $role: UserPermission!) {
+  organization(id: $orgId) {
+    invite(email: $email, role: $role) {
+      id
+      email
+      role
+      createdAt
+      acceptedAt
+    }
+  }
+}`,
+}
+
+var removeInvitationOperation = Operation{
+	Name: "RemoveInvitation",
+	Query: `mutation RemoveInvitation($orgId: ID!, $id: ID!) {
+  organization(id: $orgId) {
+    removeInvitation(id: $id)
+  }
+}`,
+}
+
+// ListMemberships returns the members of the organization orgId.
+func (cl *Client) ListMemberships(ctx context.Context, orgId string) ([]Membership, error) {
+	var response struct {
+		Organization *struct {
+			Memberships []Membership `json:"memberships"`
+		} `json:"organization"`
+	}
+
+	err := cl.Query(ctx, listMembershipsOperation, Variables{"orgId": orgId}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Organization == nil {
+		return nil, ErrNotFound
+	}
+
+	return response.Organization.Memberships, nil
+}
+
+// GetMembership returns the membership of the user userId, or ErrNotFound if
+// they are not a member of orgId.
+func (cl *Client) GetMembership(ctx context.Context, orgId, userId string) (*Membership, error) {
+	memberships, err := cl.ListMemberships(ctx, orgId)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range memberships {
+		if memberships[i].User.ID == userId {
+			return &memberships[i], nil
+		}
+	}
+
+	return nil, ErrNotFound
+}
+
+// GetMembershipByEmail returns the membership of the user with the given
+// email address, or ErrNotFound if they are not a member of orgId.
+func (cl *Client) GetMembershipByEmail(ctx context.Context, orgId, email string) (*Membership, error) {
+	memberships, err := cl.ListMemberships(ctx, orgId)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range memberships {
+		if strings.EqualFold(memberships[i].User.Email, email) {
+			return &memberships[i], nil
+		}
+	}
+
+	return nil, ErrNotFound
+}
+
+// UpdateMemberRole changes the role of the member userId of orgId.
+func (cl *Client) UpdateMemberRole(ctx context.Context, orgId, userId, role string) (*Membership, error) {
+	var response struct {
+		Organization *struct {
+			UpdateUserPermission *Membership `json:"updateUserPermission"`
+		} `json:"organization"`
+	}
+
+	err := cl.Query(ctx, updateMemberRoleOperation, Variables{
+		"orgId":  orgId,
+		"userId": userId,
+		"role":   role,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Organization == nil || response.Organization.UpdateUserPermission == nil {
+		return nil, ErrNotFound
+	}
+
+	return response.Organization.UpdateUserPermission, nil
+}
+
+// RemoveMember removes the member userId from orgId.
+func (cl *Client) RemoveMember(ctx context.Context, orgId, userId string) error {
+	var response struct {
+		Organization *struct {
+			RemoveMember interface{} `json:"removeMember"`
+		} `json:"organization"`
+	}
+
+	err := cl.Query(ctx, removeMemberOperation, Variables{
+		"orgId":  orgId,
+		"userId": userId,
+	}, &response)
+	if err != nil {
+		return err
+	}
+	if response.Organization == nil {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// GetInvitation returns the invitation id to orgId, or ErrNotFound if it was
+// removed.
+func (cl *Client) GetInvitation(ctx context.Context, orgId, id string) (*Invitation, error) {
+	var response struct {
+		Organization *struct {
+			Invitations []Invitation `json:"invitations"`
+		} `json:"organization"`
+	}
+
+	err := cl.Query(ctx, listInvitationsOperation, Variables{"orgId": orgId}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Organization == nil {
+		return nil, ErrNotFound
+	}
+
+	for i := range response.Organization.Invitations {
+		if response.Organization.Invitations[i].ID == id {
+			return &response.Organization.Invitations[i], nil
+		}
+	}
+
+	return nil, ErrNotFound
+}
+
+// Invite invites email to join orgId with the given role.
+func (cl *Client) Invite(ctx context.Context, orgId, email, role string) (*Invitation, error) {
+	var response struct {
+		Organization *struct {
+			Invite *Invitation `json:"invite"`
+		} `json:"organization"`
+	}
+
+	err := cl.Query(ctx, inviteOperation, Variables{
+		"orgId": orgId,
+		"email": email,
+		"role":  role,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Organization == nil || response.Organization.Invite == nil {
+		return nil, ErrNotFound
+	}
+
+	return response.Organization.Invite, nil
+}
+
+// RemoveInvitation revokes the invitation id to orgId.
+func (cl *Client) RemoveInvitation(ctx context.Context, orgId, id string) error {
+	var response struct {
+		Organization *struct {
+			RemoveInvitation interface{} `json:"removeInvitation"`
+		} `json:"organization"`
+	}
+
+	err := cl.Query(ctx, removeInvitationOperation, Variables{
+		"orgId": orgId,
+		"id":    id,
+	}, &response)
+	if err != nil {
+		return err
+	}
+	if response.Organization == nil {
+		return ErrNotFound
+	}
+
+	return nil
+}
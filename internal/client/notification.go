@@ -0,0 +1,342 @@
+package client
+
+import "context"
+
+const (
+	// ChannelTypeSlack posts to a Slack incoming webhook.
+	ChannelTypeSlack = "SLACK"
+	// ChannelTypeWebhook posts JSON payloads to an arbitrary URL.
+	ChannelTypeWebhook = "WEBHOOK"
+	// ChannelTypePagerDuty triggers PagerDuty incidents through an Events API v2 routing key.
+	ChannelTypePagerDuty = "PAGERDUTY"
+)
+
+// ChannelTypes are the kinds of notification channel an organization can have.
+var ChannelTypes = []string{ChannelTypeSlack, ChannelTypeWebhook, ChannelTypePagerDuty}
+
+// NotificationEvents are the graph events a variant can be subscribed to.
+var NotificationEvents = []string{"SCHEMA_CHANGE", "BUILD_ERROR", "DAILY_REPORT"}
+
+// NotificationChannel is a destination for graph notifications. Secret fields
+// are write-only and come back empty when the channel is read.
+type NotificationChannel struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Type       string `json:"type"`
+	URL        string `json:"url"`
+	RoutingKey string `json:"routingKey,omitempty"`
+	Secret     string `json:"secretToken,omitempty"`
+}
+
+// NotificationSubscription subscribes a channel to events of a graph variant.
+type NotificationSubscription struct {
+	ID      string   `json:"id"`
+	Variant string   `json:"variant"`
+	Events  []string `json:"events"`
+	Channel struct {
+		ID string `json:"id"`
+	} `json:"channel"`
+}
+
+var getNotificationChannelOperation = Operation{
+	Name: "GetNotificationChannel",
+	Query: `query GetNotificationChannel($orgId: ID!, $id: ID!) {
+  organization(id: $orgId) {
+    notificationChannel(id: $id) {
+      id
+      name
+      type
+      url
+    }
+  }
+}`,
+}
+
+var createNotificationChannelOperation = Operation{
+	Name: "CreateNotificationChannel",
+	Query: `mutation CreateNotificationChannel($orgId: ID!, $channel: NotificationChannelInput!) {
+  organization(id: $orgId) {
+    createNotificationChannel(channel: $channel) {
+      id
+      name
+      type
+      url
+    }
+  }
+}`,
+}
+
+var updateNotificationChannelOperation = Operation{
+	Name: "UpdateNotificationChannel",
+	Query: `mutation UpdateNotificationChannel($orgId: ID!, $id: ID!, $channel: NotificationChannelInput!) {
+  organization(id: $orgId) {
+    updateNotificationChannel(id: $id, channel: $channel) {
+      id
+      name
+      type
+      url
+    }
+  }
+}`,
+}
+
+var deleteNotificationChannelOperation = Operation{
+	Name: "DeleteNotificationChannel",
+	Query: `mutation DeleteNotificationChannel($orgId: ID!, $id: ID!) {
+  organization(id: $orgId) {
+    deleteNotificationChannel(id: $id)
+  }
+}`,
+}
+
+var getNotificationSubscriptionOperation = Operation{
+	Name: "GetNotificationSubscription",
+	Query: `query GetNotificationSubscription($graphId: ID!, $id: ID!) {
+  service(id: $graphId) {
+    notificationSubscription(id: $id) {
+      id
+      variant
+      events
+      channel {
+        id
+      }
+    }
+  }
+}`,
+}
+
+var createNotificationSubscriptionOperation = Operation{
+	Name: "CreateNotificationSubscription",
+	Query: `mutation CreateNotificationSubscription($graphId: ID!, $variant: String!, $channelId: ID!, $events: [NotificationEvent!]!) {
+  service(id: $graphId) {
+    createNotificationSubscription(variant: $variant, channelId: $channelId, events: $events) {
+      id
+      variant
+      events
+      channel {
+        id
+      }
+    }
+  }
+}`,
+}
+
+var updateNotificationSubscriptionOperation = Operation{
+	Name: "UpdateNotificationSubscription",
+	Query: `mutation UpdateNotificationSubscription($graphId: ID!, $id: ID!, $events: [NotificationEvent!]!) {
+  service(id: $graphId) {
+    updateNotificationSubscription(id: $id, events: $events) {
+      id
+      variant
+      events
+      channel {
+        id
+      }
+    }
+  }
+}`,
+}
+
+var deleteNotificationSubscriptionOperation = Operation{
+	Name: "DeleteNotificationSubscription",
+	Query: `mutation DeleteNotificationSubscription($graphId: ID!, $id: ID!) {
+  service(id: $graphId) {
+    deleteNotificationSubscription(id: $id)
+  }
+}`,
+}
+
+// channelInput converts a channel into the variables sent to Apollo. Empty
+// fields are left out, or sent as null to clear them when clearEmpty is set,
+// so that an update removes a secret dropped from the configuration.
+func channelInput(channel NotificationChannel, clearEmpty bool) map[string]interface{} {
+	input := map[string]interface{}{
+		"name": channel.Name,
+		"type": channel.Type,
+	}
+	optional := map[string]string{
+		"url":         channel.URL,
+		"routingKey":  channel.RoutingKey,
+		"secretToken": channel.Secret,
+	}
+	for field, value := range optional {
+		if value != "" {
+			input[field] = value
+		} else if clearEmpty {
+			input[field] = nil
+		}
+	}
+	return input
+}
+
+// GetNotificationChannel returns the channel id of orgId, or ErrNotFound if it
+// does not exist.
+func (cl *Client) GetNotificationChannel(ctx context.Context, orgId, id string) (*NotificationChannel, error) {
+	var response struct {
+		Organization *struct {
+			NotificationChannel *NotificationChannel `json:"notificationChannel"`
+		} `json:"organization"`
+	}
+
+	err := cl.Query(ctx, getNotificationChannelOperation, Variables{"orgId": orgId, "id": id}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Organization == nil || response.Organization.NotificationChannel == nil {
+		return nil, ErrNotFound
+	}
+
+	return response.Organization.NotificationChannel, nil
+}
+
+// CreateNotificationChannel creates a notification channel in orgId.
+func (cl *Client) CreateNotificationChannel(ctx context.Context, orgId string, channel NotificationChannel) (*NotificationChannel, error) {
+	var response struct {
+		Organization *struct {
+			CreateNotificationChannel *NotificationChannel `json:"createNotificationChannel"`
+		} `json:"organization"`
+	}
+
+	err := cl.Query(ctx, createNotificationChannelOperation, Variables{
+		"orgId":   orgId,
+		"channel": channelInput(channel, false),
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Organization == nil || response.Organization.CreateNotificationChannel == nil {
+		return nil, ErrNotFound
+	}
+
+	return response.Organization.CreateNotificationChannel, nil
+}
+
+// UpdateNotificationChannel replaces the settings of the channel id of orgId.
+func (cl *Client) UpdateNotificationChannel(ctx context.Context, orgId, id string, channel NotificationChannel) (*NotificationChannel, error) {
+	var response struct {
+		Organization *struct {
+			UpdateNotificationChannel *NotificationChannel `json:"updateNotificationChannel"`
+		} `json:"organization"`
+	}
+
+	err := cl.Query(ctx, updateNotificationChannelOperation, Variables{
+		"orgId":   orgId,
+		"id":      id,
+		"channel": channelInput(channel, true),
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Organization == nil || response.Organization.UpdateNotificationChannel == nil {
+		return nil, ErrNotFound
+	}
+
+	return response.Organization.UpdateNotificationChannel, nil
+}
+
+// DeleteNotificationChannel deletes the channel id of orgId.
+func (cl *Client) DeleteNotificationChannel(ctx context.Context, orgId, id string) error {
+	var response struct {
+		Organization *struct {
+			DeleteNotificationChannel interface{} `json:"deleteNotificationChannel"`
+		} `json:"organization"`
+	}
+
+	err := cl.Query(ctx, deleteNotificationChannelOperation, Variables{"orgId": orgId, "id": id}, &response)
+	if err != nil {
+		return err
+	}
+	if response.Organization == nil {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// GetNotificationSubscription returns the subscription id of graphId, or
+// ErrNotFound if it does not exist.
+func (cl *Client) GetNotificationSubscription(ctx context.Context, graphId, id string) (*NotificationSubscription, error) {
+	var response struct {
+		Service *struct {
+			NotificationSubscription *NotificationSubscription `json:"notificationSubscription"`
+		} `json:"service"`
+	}
+
+	err := cl.Query(ctx, getNotificationSubscriptionOperation, Variables{"graphId": graphId, "id": id}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Service == nil || response.Service.NotificationSubscription == nil {
+		return nil, ErrNotFound
+	}
+
+	return response.Service.NotificationSubscription, nil
+}
+
+// CreateNotificationSubscription subscribes channelId to events of the
+// variant of graphId.
+func (cl *Client) CreateNotificationSubscription(ctx context.Context, graphId, variant, channelId string, events []string) (*NotificationSubscription, error) {
+	var response struct {
+		Service *struct {
+			CreateNotificationSubscription *NotificationSubscription `json:"createNotificationSubscription"`
+		} `json:"service"`
+	}
+
+	err := cl.Query(ctx, createNotificationSubscriptionOperation, Variables{
+		"graphId":   graphId,
+		"variant":   variant,
+		"channelId": channelId,
+		"events":    events,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Service == nil || response.Service.CreateNotificationSubscription == nil {
+		return nil, ErrNotFound
+	}
+
+	return response.Service.CreateNotificationSubscription, nil
+}
+
+// UpdateNotificationSubscription replaces the events of the subscription id of
+// graphId.
+func (cl *Client) UpdateNotificationSubscription(ctx context.Context, graphId, id string, events []string) (*NotificationSubscription, error) {
+	var response struct {
+		Service *struct {
+			UpdateNotificationSubscription *NotificationSubscription `json:"updateNotificationSubscription"`
+		} `json:"service"`
+	}
+
+	err := cl.Query(ctx, updateNotificationSubscriptionOperation, Variables{
+		"graphId": graphId,
+		"id":      id,
+		"events":  events,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Service == nil || response.Service.UpdateNotificationSubscription == nil {
+		return nil, ErrNotFound
+	}
+
+	return response.Service.UpdateNotificationSubscription, nil
+}
+
+// DeleteNotificationSubscription deletes the subscription id of graphId.
+func (cl *Client) DeleteNotificationSubscription(ctx context.Context, graphId, id string) error {
+	var response struct {
+		Service *struct {
+			DeleteNotificationSubscription interface{} `json:"deleteNotificationSubscription"`
+		} `json:"service"`
+	}
+
+	err := cl.Query(ctx, deleteNotificationSubscriptionOperation, Variables{"graphId": graphId, "id": id}, &response)
+	if err != nil {
+		return err
+	}
+	if response.Service == nil {
+		return ErrNotFound
+	}
+
+	return nil
+}
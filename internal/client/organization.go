@@ -0,0 +1,124 @@
+package client
+
+import (
+	"context"
+	"strings"
+)
+
+// Organization is an Apollo organization (an "account" in the Platform API).
+type Organization struct {
+	ID          string       `json:"id"`
+	Name        string       `json:"name"`
+	CurrentPlan *BillingPlan `json:"currentPlan"`
+}
+
+// BillingPlan is the plan an organization is subscribed to.
+type BillingPlan struct {
+	ID           string       `json:"id"`
+	Name         string       `json:"name"`
+	Tier         string       `json:"tier"`
+	Capabilities Capabilities `json:"capabilities"`
+}
+
+// Capabilities are the features enabled by a billing plan.
+type Capabilities struct {
+	Contracts        bool `json:"contracts"`
+	CustomChecks     bool `json:"customChecks"`
+	Linting          bool `json:"linting"`
+	PersistedQueries bool `json:"persistedQueries"`
+}
+
+// IsEnterpriseTier reports whether a billing plan tier includes enterprise
+// features such as contracts.
+func IsEnterpriseTier(tier string) bool {
+	return strings.Contains(tier, "ENTERPRISE")
+}
+
+// Enterprise reports whether the organization is on an enterprise plan.
+func (o *Organization) Enterprise() bool {
+	return o.CurrentPlan != nil && IsEnterpriseTier(o.CurrentPlan.Tier)
+}
+
+// OrganizationGraph is a graph as listed for an organization.
+type OrganizationGraph struct {
+	ID        string `json:"id"`
+	Title     string `json:"title"`
+	CreatedAt string `json:"createdAt"`
+	Variants  []struct {
+		Name string `json:"name"`
+	} `json:"variants"`
+}
+
+var getOrganizationOperation = Operation{
+	Name: "GetOrganization",
+	Query: `query GetOrganization($id: ID!) {
+  organization(id: $id) {
+    id
+    name
+    currentPlan {
+      id
+      name
+      tier
+      capabilities {
+        contracts
+        customChecks
+        linting
+        persistedQueries
+      }
+    }
+  }
+}`,
+}
+
+var listGraphsOperation = Operation{
+	Name: "ListGraphs",
+	Query: `query ListGraphs($id: ID!) {
+  organization(id: $id) {
+    graphs {
+      id
+      title
+      createdAt
+      variants {
+        name
+      }
+    }
+  }
+}`,
+}
+
+// GetOrganization returns the organization id, or ErrNotFound if it does not
+// exist or the API key is not a member of it.
+func (cl *Client) GetOrganization(ctx context.Context, id string) (*Organization, error) {
+	var response struct {
+		Organization *Organization `json:"organization"`
+	}
+
+	err := cl.Query(ctx, getOrganizationOperation, Variables{"id": id}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Organization == nil {
+		return nil, ErrNotFound
+	}
+
+	return response.Organization, nil
+}
+
+// ListGraphs returns every graph of the organization id.
+func (cl *Client) ListGraphs(ctx context.Context, id string) ([]OrganizationGraph, error) {
+	var response struct {
+		Organization *struct {
+			Graphs []OrganizationGraph `json:"graphs"`
+		} `json:"organization"`
+	}
+
+	err := cl.Query(ctx, listGraphsOperation, Variables{"id": id}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Organization == nil {
+		return nil, ErrNotFound
+	}
+
+	return response.Organization.Graphs, nil
+}
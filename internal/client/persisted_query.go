@@ -0,0 +1,284 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// PersistedQueryList is a safelist of operations that variants linked to it
+// accept.
+type PersistedQueryList struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	LinkedVariants []struct {
+		Name string `json:"name"`
+	} `json:"linkedVariants"`
+}
+
+// LinkedVariantNames returns the names of the variants linked to the list.
+func (l *PersistedQueryList) LinkedVariantNames() []string {
+	names := make([]string, 0, len(l.LinkedVariants))
+	for _, variant := range l.LinkedVariants {
+		names = append(names, variant.Name)
+	}
+	return names
+}
+
+// PersistedOperation is an operation of a persisted query list. Type is one of
+// query, mutation or subscription, as in operation manifests.
+type PersistedOperation struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Body string `json:"body"`
+}
+
+var getPersistedQueryListOperation = Operation{
+	Name: "GetPersistedQueryList",
+	Query: `query GetPersistedQueryList($graphId: ID!, $id: ID!) {
+  service(id: $graphId) {
+    persistedQueryList(id: $id) {
+      id
+      name
+      description
+      linkedVariants {
+        name
+      }
+    }
+  }
+}`,
+}
+
+var createPersistedQueryListOperation = Operation{
+	Name: "CreatePersistedQueryList",
+	Query: `mutation CreatePersistedQueryList($graphId: ID!, $name: String!, $description: String, $linkedVariants: [String!]!) {
+  service(id: $graphId) {
+    createPersistedQueryList(name: $name, description: $description, linkedVariantNames: $linkedVariants) {
+      id
+      name
+      description
+      linkedVariants {
+        name
+      }
+    }
+  }
+}`,
+}
+
+var updatePersistedQueryListOperation = Operation{
+	Name: "UpdatePersistedQueryList",
+	Query: `mutation UpdatePersistedQueryList($graphId: ID!, $id: ID!, $name: String!, $description: String, $linkedVariants: [String!]!) {
+  service(id: $graphId) {
+    updatePersistedQueryList(id: $id, name: $name, description: $description, linkedVariantNames: $linkedVariants) {
+      id
+      name
+      description
+      linkedVariants {
+        name
+      }
+    }
+  }
+}`,
+}
+
+var deletePersistedQueryListOperation = Operation{
+	Name: "DeletePersistedQueryList",
+	Query: `mutation DeletePersistedQueryList($graphId: ID!, $id: ID!) {
+  service(id: $graphId) {
+    deletePersistedQueryList(id: $id)
+  }
+}`,
+}
+
+var listPersistedOperationsOperation = Operation{
+	Name: "ListPersistedOperations",
+	Query: `query ListPersistedOperations($graphId: ID!, $id: ID!) {
+  service(id: $graphId) {
+    persistedQueryList(id: $id) {
+      operations {
+        id
+        name
+        type
+        body
+      }
+    }
+  }
+}`,
+}
+
+var publishPersistedOperationsOperation = Operation{
+	Name: "PublishPersistedOperations",
+	Query: `mutation PublishPersistedOperations($graphId: ID!, $id: ID!, $operations: [PersistedQueryInput!]!, $removeIds: [ID!]!) {
+  service(id: $graphId) {
+    persistedQueryList(id: $id) {
+      publishOperations(operations: $operations, removeOperations: { byId: $removeIds }) {
+        errorMessages
+      }
+    }
+  }
+}`,
+}
+
+// GetPersistedQueryList returns the list id of graphId, or ErrNotFound if it
+// does not exist.
+func (cl *Client) GetPersistedQueryList(ctx context.Context, graphId, id string) (*PersistedQueryList, error) {
+	var response struct {
+		Service *struct {
+			PersistedQueryList *PersistedQueryList `json:"persistedQueryList"`
+		} `json:"service"`
+	}
+
+	err := cl.Query(ctx, getPersistedQueryListOperation, Variables{"graphId": graphId, "id": id}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Service == nil || response.Service.PersistedQueryList == nil {
+		return nil, ErrNotFound
+	}
+
+	return response.Service.PersistedQueryList, nil
+}
+
+// CreatePersistedQueryList creates a persisted query list in graphId linked to
+// the given variants.
+func (cl *Client) CreatePersistedQueryList(ctx context.Context, graphId, name string, description *string, linkedVariants []string) (*PersistedQueryList, error) {
+	var response struct {
+		Service *struct {
+			CreatePersistedQueryList *PersistedQueryList `json:"createPersistedQueryList"`
+		} `json:"service"`
+	}
+
+	err := cl.Query(ctx, createPersistedQueryListOperation, Variables{
+		"graphId":        graphId,
+		"name":           name,
+		"description":    description,
+		"linkedVariants": linkedVariants,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Service == nil || response.Service.CreatePersistedQueryList == nil {
+		return nil, ErrNotFound
+	}
+
+	return response.Service.CreatePersistedQueryList, nil
+}
+
+// UpdatePersistedQueryList replaces the name, description and linked variants
+// of the list id of graphId.
+func (cl *Client) UpdatePersistedQueryList(ctx context.Context, graphId, id, name string, description *string, linkedVariants []string) (*PersistedQueryList, error) {
+	var response struct {
+		Service *struct {
+			UpdatePersistedQueryList *PersistedQueryList `json:"updatePersistedQueryList"`
+		} `json:"service"`
+	}
+
+	err := cl.Query(ctx, updatePersistedQueryListOperation, Variables{
+		"graphId":        graphId,
+		"id":             id,
+		"name":           name,
+		"description":    description,
+		"linkedVariants": linkedVariants,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Service == nil || response.Service.UpdatePersistedQueryList == nil {
+		return nil, ErrNotFound
+	}
+
+	return response.Service.UpdatePersistedQueryList, nil
+}
+
+// DeletePersistedQueryList deletes the list id of graphId.
+func (cl *Client) DeletePersistedQueryList(ctx context.Context, graphId, id string) error {
+	var response struct {
+		Service *struct {
+			DeletePersistedQueryList interface{} `json:"deletePersistedQueryList"`
+		} `json:"service"`
+	}
+
+	err := cl.Query(ctx, deletePersistedQueryListOperation, Variables{"graphId": graphId, "id": id}, &response)
+	if err != nil {
+		return err
+	}
+	if response.Service == nil {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// ListPersistedOperations returns the operations of the list id of graphId.
+func (cl *Client) ListPersistedOperations(ctx context.Context, graphId, id string) ([]PersistedOperation, error) {
+	var response struct {
+		Service *struct {
+			PersistedQueryList *struct {
+				Operations []PersistedOperation `json:"operations"`
+			} `json:"persistedQueryList"`
+		} `json:"service"`
+	}
+
+	err := cl.Query(ctx, listPersistedOperationsOperation, Variables{"graphId": graphId, "id": id}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Service == nil || response.Service.PersistedQueryList == nil {
+		return nil, ErrNotFound
+	}
+
+	operations := response.Service.PersistedQueryList.Operations
+	for i := range operations {
+		operations[i].Type = strings.ToLower(operations[i].Type)
+	}
+
+	return operations, nil
+}
+
+// PublishPersistedOperations adds or replaces operations in the list id of
+// graphId and removes the operations with the IDs in remove. Apollo applies
+// both changes as a single revision of the list.
+func (cl *Client) PublishPersistedOperations(ctx context.Context, graphId, id string, operations []PersistedOperation, remove []string) error {
+	var response struct {
+		Service *struct {
+			PersistedQueryList *struct {
+				PublishOperations *struct {
+					ErrorMessages []string `json:"errorMessages"`
+				} `json:"publishOperations"`
+			} `json:"persistedQueryList"`
+		} `json:"service"`
+	}
+
+	input := make([]map[string]interface{}, 0, len(operations))
+	for _, operation := range operations {
+		input = append(input, map[string]interface{}{
+			"id":   operation.ID,
+			"name": operation.Name,
+			"type": strings.ToUpper(operation.Type),
+			"body": operation.Body,
+		})
+	}
+	if remove == nil {
+		remove = []string{}
+	}
+
+	err := cl.Query(ctx, publishPersistedOperationsOperation, Variables{
+		"graphId":    graphId,
+		"id":         id,
+		"operations": input,
+		"removeIds":  remove,
+	}, &response)
+	if err != nil {
+		return err
+	}
+	if response.Service == nil || response.Service.PersistedQueryList == nil || response.Service.PersistedQueryList.PublishOperations == nil {
+		return ErrNotFound
+	}
+	if messages := response.Service.PersistedQueryList.PublishOperations.ErrorMessages; len(messages) > 0 {
+		return errors.New(strings.Join(messages, "; "))
+	}
+
+	return nil
+}
@@ -0,0 +1,216 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Subgraph is a federated subgraph published to a graph variant.
+type Subgraph struct {
+	Name                string `json:"name"`
+	URL                 string `json:"url"`
+	Revision            string `json:"revision"`
+	ActivePartialSchema struct {
+		SDL string `json:"sdl"`
+	} `json:"activePartialSchema"`
+}
+
+// CompositionError is an error reported by Apollo while composing the
+// supergraph of a variant.
+type CompositionError struct {
+	Message string `json:"message"`
+	Code    string `json:"code"`
+}
+
+func (e CompositionError) String() string {
+	if e.Code != "" {
+		return fmt.Sprintf("%s: %s", e.Code, e.Message)
+	}
+	return e.Message
+}
+
+// SubgraphRef returns the identifier of a subgraph, in the form
+// "graph@variant/subgraph".
+func SubgraphRef(graphId, variant, name string) string {
+	return VariantRef(graphId, variant) + "/" + name
+}
+
+// ParseSubgraphRef splits a subgraph identifier ("graph@variant/subgraph")
+// into its graph ID, variant and subgraph name.
+func ParseSubgraphRef(ref string) (graphId, variant, name string, err error) {
+	variantRef, name, ok := strings.Cut(ref, "/")
+	if ok && name != "" {
+		graphId, variant, err = ParseVariantRef(variantRef)
+	}
+	if !ok || name == "" || err != nil {
+		return "", "", "", fmt.Errorf("expected a subgraph identifier with format <graph_id>@<variant>/<subgraph>, got %q", ref)
+	}
+	return graphId, variant, name, nil
+}
+
+var getSubgraphOperation = Operation{
+	Name: "GetSubgraph",
+	Query: `query GetSubgraph($graphId: ID!, $variant: String!, $name: ID!) {
+  service(id: $graphId) {
+    variant(name: $variant) {
+      subgraph(name: $name) {
+        name
+        url
+        revision
+        activePartialSchema {
+          sdl
+        }
+      }
+    }
+  }
+}`,
+}
+
+var publishSubgraphOperation = Operation{
+	Name: "PublishSubgraph",
+	Query: `mutation PublishSubgraph($graphId: ID!, $variant: String!, $name: String!, $url: String, $revision: String!, $sdl: String!) {
+  service(id: $graphId) {
+    publishSubgraph(graphVariant: $variant, name: $name, url: $url, revision: $revision, activePartialSchema: {sdl: $sdl}) {
+      errors {
+        message
+        code
+      }
+    }
+  }
+}`,
+}
+
+var validateSubgraphOperation = Operation{
+	Name: "ValidateSubgraph",
+	Query: `query ValidateSubgraph($graphId: ID!, $variant: String!, $name: String!, $sdl: String!) {
+  service(id: $graphId) {
+    validatePartialSchemaOfImplementingServiceAgainstGraph(graphVariant: $variant, implementingServiceName: $name, partialSchema: {sdl: $sdl}) {
+      errors {
+        message
+        code
+      }
+    }
+  }
+}`,
+}
+
+var removeSubgraphOperation = Operation{
+	Name: "RemoveSubgraph",
+	Query: `mutation RemoveSubgraph($graphId: ID!, $variant: String!, $name: String!) {
+  service(id: $graphId) {
+    removeImplementingServiceAndTriggerComposition(graphVariant: $variant, name: $name, dryRun: false) {
+      errors {
+        message
+        code
+      }
+    }
+  }
+}`,
+}
+
+type compositionResult struct {
+	Errors []CompositionError `json:"errors"`
+}
+
+// GetSubgraph returns the subgraph name of the given graph variant, or
+// ErrNotFound if it has not been published.
+func (cl *Client) GetSubgraph(ctx context.Context, graphId, variant, name string) (*Subgraph, error) {
+	var response struct {
+		Service *struct {
+			Variant *struct {
+				Subgraph *Subgraph `json:"subgraph"`
+			} `json:"variant"`
+		} `json:"service"`
+	}
+
+	err := cl.Query(ctx, getSubgraphOperation, Variables{
+		"graphId": graphId,
+		"variant": variant,
+		"name":    name,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Service == nil || response.Service.Variant == nil || response.Service.Variant.Subgraph == nil {
+		return nil, ErrNotFound
+	}
+
+	return response.Service.Variant.Subgraph, nil
+}
+
+// PublishSubgraph publishes sdl as the schema of the subgraph name, served at
+// url, and returns the composition errors it caused.
+func (cl *Client) PublishSubgraph(ctx context.Context, graphId, variant, name, url, revision, sdl string) ([]CompositionError, error) {
+	var response struct {
+		Service *struct {
+			PublishSubgraph *compositionResult `json:"publishSubgraph"`
+		} `json:"service"`
+	}
+
+	err := cl.Query(ctx, publishSubgraphOperation, Variables{
+		"graphId":  graphId,
+		"variant":  variant,
+		"name":     name,
+		"url":      url,
+		"revision": revision,
+		"sdl":      sdl,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Service == nil || response.Service.PublishSubgraph == nil {
+		return nil, ErrNotFound
+	}
+
+	return response.Service.PublishSubgraph.Errors, nil
+}
+
+// ValidateSubgraph composes sdl with the other subgraphs of the variant
+// without publishing it and returns the resulting composition errors.
+func (cl *Client) ValidateSubgraph(ctx context.Context, graphId, variant, name, sdl string) ([]CompositionError, error) {
+	var response struct {
+		Service *struct {
+			Validate *compositionResult `json:"validatePartialSchemaOfImplementingServiceAgainstGraph"`
+		} `json:"service"`
+	}
+
+	err := cl.Query(ctx, validateSubgraphOperation, Variables{
+		"graphId": graphId,
+		"variant": variant,
+		"name":    name,
+		"sdl":     sdl,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Service == nil || response.Service.Validate == nil {
+		return nil, ErrNotFound
+	}
+
+	return response.Service.Validate.Errors, nil
+}
+
+// RemoveSubgraph removes the subgraph name from the variant and returns the
+// composition errors it caused.
+func (cl *Client) RemoveSubgraph(ctx context.Context, graphId, variant, name string) ([]CompositionError, error) {
+	var response struct {
+		Service *struct {
+			Remove *compositionResult `json:"removeImplementingServiceAndTriggerComposition"`
+		} `json:"service"`
+	}
+
+	err := cl.Query(ctx, removeSubgraphOperation, Variables{
+		"graphId": graphId,
+		"variant": variant,
+		"name":    name,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Service == nil || response.Service.Remove == nil {
+		return nil, ErrNotFound
+	}
+
+	return response.Service.Remove.Errors, nil
+}
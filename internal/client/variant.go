@@ -0,0 +1,205 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Variant is a variant of an Apollo graph, such as "current" or "staging".
+type Variant struct {
+	ID              string  `json:"id"`
+	Name            string  `json:"name"`
+	IsPublic        bool    `json:"isPublic"`
+	PreflightScript *string `json:"preflightScript"`
+	SharedHeaders   *string `json:"sharedHeaders"`
+}
+
+// VariantSettings are the Explorer settings of a variant that can be changed
+// in place.
+type VariantSettings struct {
+	IsPublic        bool
+	PreflightScript *string
+	DefaultHeaders  map[string]string
+}
+
+// DefaultHeaders decodes the shared Explorer headers of the variant.
+func (v *Variant) DefaultHeaders() (map[string]string, error) {
+	if v.SharedHeaders == nil || *v.SharedHeaders == "" {
+		return nil, nil
+	}
+
+	var headers map[string]string
+	if err := json.Unmarshal([]byte(*v.SharedHeaders), &headers); err != nil {
+		return nil, fmt.Errorf("decoding shared headers of variant %s: %w", v.ID, err)
+	}
+	return headers, nil
+}
+
+// VariantRef returns the graph ref ("graph@variant") of a variant.
+func VariantRef(graphId, name string) string {
+	return graphId + "@" + name
+}
+
+// ParseVariantRef splits a graph ref ("graph@variant") into its graph ID and
+// variant name.
+func ParseVariantRef(ref string) (graphId, name string, err error) {
+	graphId, name, ok := strings.Cut(ref, "@")
+	if !ok || graphId == "" || name == "" {
+		return "", "", fmt.Errorf("expected a graph ref with format <graph_id>@<variant>, got %q", ref)
+	}
+	return graphId, name, nil
+}
+
+const variantFields = `
+      id
+      name
+      isPublic
+      preflightScript
+      sharedHeaders`
+
+var createVariantOperation = Operation{
+	Name: "CreateVariant",
+	Query: `mutation CreateVariant($graphId: ID!, $name: String!) {
+  service(id: $graphId) {
+    createVariant(name: $name) {` + variantFields + `
+    }
+  }
+}`,
+}
+
+var getVariantOperation = Operation{
+	Name: "GetVariant",
+	Query: `query GetVariant($graphId: ID!, $name: String!) {
+  service(id: $graphId) {
+    variant(name: $name) {` + variantFields + `
+    }
+  }
+}`,
+}
+
+var updateVariantOperation = Operation{
+	Name: "UpdateVariant",
+	Query: `mutation UpdateVariant($ref: ID!, $isPublic: Boolean!, $preflightScript: String, $sharedHeaders: String) {
+  variant(ref: $ref) {
+    updateVariantIsPublic(isPublic: $isPublic) {
+      id
+    }
+    updatePreflightScript(preflightScript: $preflightScript) {
+      id
+    }
+    updateSharedHeaders(sharedHeaders: $sharedHeaders) {` + variantFields + `
+    }
+  }
+}`,
+}
+
+var deleteVariantOperation = Operation{
+	Name: "DeleteVariant",
+	Query: `mutation DeleteVariant($ref: ID!) {
+  variant(ref: $ref) {
+    delete {
+      deleted
+    }
+  }
+}`,
+}
+
+// CreateVariant creates the variant name of the graph graphId.
+func (cl *Client) CreateVariant(ctx context.Context, graphId, name string) (*Variant, error) {
+	var response struct {
+		Service *struct {
+			CreateVariant *Variant `json:"createVariant"`
+		} `json:"service"`
+	}
+
+	err := cl.Query(ctx, createVariantOperation, Variables{
+		"graphId": graphId,
+		"name":    name,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Service == nil || response.Service.CreateVariant == nil {
+		return nil, ErrNotFound
+	}
+
+	return response.Service.CreateVariant, nil
+}
+
+// GetVariant returns the variant name of the graph graphId, or ErrNotFound if
+// either does not exist.
+func (cl *Client) GetVariant(ctx context.Context, graphId, name string) (*Variant, error) {
+	var response struct {
+		Service *struct {
+			Variant *Variant `json:"variant"`
+		} `json:"service"`
+	}
+
+	err := cl.Query(ctx, getVariantOperation, Variables{
+		"graphId": graphId,
+		"name":    name,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Service == nil || response.Service.Variant == nil {
+		return nil, ErrNotFound
+	}
+
+	return response.Service.Variant, nil
+}
+
+// UpdateVariant applies settings to the variant name of the graph graphId.
+func (cl *Client) UpdateVariant(ctx context.Context, graphId, name string, settings VariantSettings) (*Variant, error) {
+	var sharedHeaders *string
+	if len(settings.DefaultHeaders) > 0 {
+		encoded, err := json.Marshal(settings.DefaultHeaders)
+		if err != nil {
+			return nil, fmt.Errorf("encoding shared headers: %w", err)
+		}
+		headers := string(encoded)
+		sharedHeaders = &headers
+	}
+
+	var response struct {
+		Variant *struct {
+			UpdateSharedHeaders *Variant `json:"updateSharedHeaders"`
+		} `json:"variant"`
+	}
+
+	err := cl.Query(ctx, updateVariantOperation, Variables{
+		"ref":             VariantRef(graphId, name),
+		"isPublic":        settings.IsPublic,
+		"preflightScript": settings.PreflightScript,
+		"sharedHeaders":   sharedHeaders,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Variant == nil || response.Variant.UpdateSharedHeaders == nil {
+		return nil, ErrNotFound
+	}
+
+	return response.Variant.UpdateSharedHeaders, nil
+}
+
+// DeleteVariant deletes the variant name of the graph graphId.
+func (cl *Client) DeleteVariant(ctx context.Context, graphId, name string) error {
+	var response struct {
+		Variant *struct {
+			Delete interface{} `json:"delete"`
+		} `json:"variant"`
+	}
+
+	err := cl.Query(ctx, deleteVariantOperation, Variables{"ref": VariantRef(graphId, name)}, &response)
+	if err != nil {
+		return err
+	}
+	if response.Variant == nil {
+		return ErrNotFound
+	}
+
+	return nil
+}
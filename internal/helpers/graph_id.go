@@ -0,0 +1,36 @@
+package helpers
+
+import (
+	"regexp"
+	"strings"
+)
+
+// GraphIdMaxLength is the longest graph ID accepted by Apollo.
+const GraphIdMaxLength = 64
+
+// graphIdSuffixLength is the number of random digits appended to generated IDs.
+const graphIdSuffixLength = 5
+
+// GraphIdPattern matches the graph IDs accepted by Apollo: a leading letter
+// followed by letters, digits, underscores and hyphens.
+var GraphIdPattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_-]*$`)
+
+var invalidGraphIdChars = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// GraphIdFromName derives a valid graph ID from a graph name by lowercasing it,
+// replacing unsupported characters with hyphens and appending random digits.
+func GraphIdFromName(name string) string {
+	base := invalidGraphIdChars.ReplaceAllString(strings.ToLower(name), "-")
+	base = strings.Trim(base, "-_")
+	if base == "" {
+		base = "graph"
+	} else if base[0] < 'a' || base[0] > 'z' {
+		base = "graph-" + base
+	}
+
+	if max := GraphIdMaxLength - graphIdSuffixLength - 1; len(base) > max {
+		base = strings.TrimRight(base[:max], "-_")
+	}
+
+	return base + "-" + RandomNumberString(graphIdSuffixLength)
+}
@@ -0,0 +1,26 @@
+package helpers
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGraphIdFromName(t *testing.T) {
+	cases := map[string]string{
+		"my-graph":              "my-graph-",
+		`My "Quoted" Graph`:     "my-quoted-graph-",
+		"42 things":             "graph-42-things-",
+		"!!!":                   "graph-",
+		strings.Repeat("a", 80): strings.Repeat("a", GraphIdMaxLength-graphIdSuffixLength-1) + "-",
+	}
+
+	for name, prefix := range cases {
+		id := GraphIdFromName(name)
+		if !strings.HasPrefix(id, prefix) {
+			t.Errorf("GraphIdFromName(%q) = %q, want prefix %q", name, id, prefix)
+		}
+		if !GraphIdPattern.MatchString(id) || len(id) > GraphIdMaxLength {
+			t.Errorf("GraphIdFromName(%q) = %q is not a valid graph ID", name, id)
+		}
+	}
+}
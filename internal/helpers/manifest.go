@@ -0,0 +1,56 @@
+package helpers
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ManifestFormat is the format identifier of persisted query manifests
+// produced by Apollo's tooling.
+const ManifestFormat = "apollo-persisted-query-manifest"
+
+// ManifestOperation is a single operation of a persisted query manifest.
+type ManifestOperation struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Body string `json:"body"`
+}
+
+// ParseOperationManifest decodes a persisted query manifest and checks that
+// every operation is complete and has a unique ID.
+func ParseOperationManifest(data []byte) ([]ManifestOperation, error) {
+	var manifest struct {
+		Format     string              `json:"format"`
+		Version    int                 `json:"version"`
+		Operations []ManifestOperation `json:"operations"`
+	}
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("decoding manifest: %w", err)
+	}
+	if manifest.Format != ManifestFormat || manifest.Version != 1 {
+		return nil, fmt.Errorf("expected a %s version 1 manifest, got format %q version %d", ManifestFormat, manifest.Format, manifest.Version)
+	}
+
+	seen := make(map[string]bool, len(manifest.Operations))
+	for i, operation := range manifest.Operations {
+		if operation.ID == "" || operation.Name == "" || operation.Body == "" {
+			return nil, fmt.Errorf("operation %d: id, name and body are required", i)
+		}
+		switch operation.Type {
+		case "query", "mutation", "subscription":
+		default:
+			return nil, fmt.Errorf("operation %s: type must be query, mutation or subscription, got %q", operation.ID, operation.Type)
+		}
+		if seen[operation.ID] {
+			return nil, fmt.Errorf("operation %s appears more than once", operation.ID)
+		}
+		seen[operation.ID] = true
+	}
+
+	if manifest.Operations == nil {
+		manifest.Operations = []ManifestOperation{}
+	}
+
+	return manifest.Operations, nil
+}
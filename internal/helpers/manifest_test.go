@@ -0,0 +1,45 @@
+package helpers
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseOperationManifest(t *testing.T) {
+	operations, err := ParseOperationManifest([]byte(`{
+  "format": "apollo-persisted-query-manifest",
+  "version": 1,
+  "operations": [
+    {"id": "abc", "name": "GetUser", "type": "query", "body": "query GetUser { user { id } }"}
+  ]
+}`))
+	if err != nil {
+		t.Fatalf("ParseOperationManifest returned error: %s", err)
+	}
+	if len(operations) != 1 || operations[0].ID != "abc" || operations[0].Type != "query" {
+		t.Errorf("unexpected operations %+v", operations)
+	}
+}
+
+func TestParseOperationManifestErrors(t *testing.T) {
+	const header = `"format": "apollo-persisted-query-manifest", "version": 1`
+
+	cases := []struct {
+		manifest string
+		want     string
+	}{
+		{`not json`, "decoding manifest"},
+		{`{"format": "other", "version": 1}`, "expected a apollo-persisted-query-manifest"},
+		{`{"format": "apollo-persisted-query-manifest", "version": 2}`, "version 2"},
+		{`{` + header + `, "operations": [{"id": "a", "name": "A", "type": "query"}]}`, "body are required"},
+		{`{` + header + `, "operations": [{"id": "a", "name": "A", "type": "fragment", "body": "x"}]}`, "type must be"},
+		{`{` + header + `, "operations": [{"id": "a", "name": "A", "type": "query", "body": "x"}, {"id": "a", "name": "B", "type": "query", "body": "y"}]}`, "more than once"},
+	}
+
+	for _, c := range cases {
+		_, err := ParseOperationManifest([]byte(c.manifest))
+		if err == nil || !strings.Contains(err.Error(), c.want) {
+			t.Errorf("ParseOperationManifest(%s) error = %v, want it to contain %q", c.manifest, err, c.want)
+		}
+	}
+}
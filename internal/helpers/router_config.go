@@ -0,0 +1,70 @@
+package helpers
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ParseRouterConfig decodes a router configuration given as YAML. JSON is
+// accepted too, since it is a subset of YAML. The top level must be a map.
+func ParseRouterConfig(config string) (map[string]interface{}, error) {
+	var value map[string]interface{}
+	if err := yaml.Unmarshal([]byte(config), &value); err != nil {
+		return nil, fmt.Errorf("decoding router config: %w", err)
+	}
+	if value == nil {
+		return nil, fmt.Errorf("router config must be a map of settings")
+	}
+	return value, nil
+}
+
+// RouterConfigYAML re-encodes a router configuration as YAML, the format
+// Apollo stores it in.
+func RouterConfigYAML(config string) (string, error) {
+	value, err := ParseRouterConfig(config)
+	if err != nil {
+		return "", err
+	}
+
+	out, err := yaml.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("encoding router config: %w", err)
+	}
+	return string(out), nil
+}
+
+// RouterConfigJSON re-encodes a router configuration as JSON, matching the
+// output of Terraform's jsonencode.
+func RouterConfigJSON(config string) (string, error) {
+	value, err := ParseRouterConfig(config)
+	if err != nil {
+		return "", err
+	}
+
+	out, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("encoding router config: %w", err)
+	}
+	return string(out), nil
+}
+
+// RouterConfigEqual reports whether two router configurations hold the same
+// settings, ignoring formatting, key order and comments.
+func RouterConfigEqual(a, b string) bool {
+	va, err := ParseRouterConfig(a)
+	if err != nil {
+		return false
+	}
+	vb, err := ParseRouterConfig(b)
+	if err != nil {
+		return false
+	}
+
+	// Round-trip through JSON so that numbers decoded as int and float64
+	// compare equal.
+	ja, errA := json.Marshal(va)
+	jb, errB := json.Marshal(vb)
+	return errA == nil && errB == nil && string(ja) == string(jb)
+}
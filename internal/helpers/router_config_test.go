@@ -0,0 +1,29 @@
+package helpers
+
+import "testing"
+
+func TestRouterConfigEqual(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want bool
+	}{
+		{"supergraph:\n  listen: 0.0.0.0:4000\n", `{"supergraph": {"listen": "0.0.0.0:4000"}}`, true},
+		{"# comment\nb: 1\na: [x, y]\n", "a:\n  - x\n  - y\nb: 1.0\n", true},
+		{"a: 1\n", "a: 2\n", false},
+		{"a: 1\n", "not: [valid", false},
+	}
+
+	for _, c := range cases {
+		if got := RouterConfigEqual(c.a, c.b); got != c.want {
+			t.Errorf("RouterConfigEqual(%q, %q) = %t, want %t", c.a, c.b, got, c.want)
+		}
+	}
+}
+
+func TestParseRouterConfigRequiresMap(t *testing.T) {
+	for _, config := range []string{"", "- a\n- b\n", "just a string"} {
+		if _, err := ParseRouterConfig(config); err == nil {
+			t.Errorf("ParseRouterConfig(%q) returned no error", config)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package helpers
+
+import "strings"
+
+// sdlPunctuators are the single character GraphQL punctuators. The spread
+// "..." is handled separately.
+const sdlPunctuators = "!$&()/:=@[]{}|"
+
+// SDLEqual reports whether two GraphQL schema documents consist of the same
+// tokens, ignoring whitespace, commas and comments.
+func SDLEqual(a, b string) bool {
+	ta, tb := sdlTokens(a), sdlTokens(b)
+	if len(ta) != len(tb) {
+		return false
+	}
+	for i := range ta {
+		if ta[i] != tb[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// sdlTokens splits a GraphQL document into its significant tokens. String
+// literals, including block strings, are kept verbatim as single tokens.
+func sdlTokens(sdl string) []string {
+	var tokens []string
+
+	for i := 0; i < len(sdl); {
+		c := sdl[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',':
+			i++
+		case c == '#':
+			for i < len(sdl) && sdl[i] != '\n' && sdl[i] != '\r' {
+				i++
+			}
+		case strings.HasPrefix(sdl[i:], `"""`):
+			end := i + 3
+			for end < len(sdl) && !strings.HasPrefix(sdl[end:], `"""`) {
+				if strings.HasPrefix(sdl[end:], `\"""`) {
+					end += 4
+				} else {
+					end++
+				}
+			}
+			end += 3
+			if end > len(sdl) {
+				end = len(sdl)
+			}
+			tokens = append(tokens, sdl[i:end])
+			i = end
+		case c == '"':
+			end := i + 1
+			for end < len(sdl) && sdl[end] != '"' && sdl[end] != '\n' {
+				if sdl[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			end++
+			if end > len(sdl) {
+				end = len(sdl)
+			}
+			tokens = append(tokens, sdl[i:end])
+			i = end
+		case strings.HasPrefix(sdl[i:], "..."):
+			tokens = append(tokens, "...")
+			i += 3
+		case strings.IndexByte(sdlPunctuators, c) >= 0:
+			tokens = append(tokens, string(c))
+			i++
+		default:
+			end := i + 1
+			for end < len(sdl) && !strings.ContainsRune(" \t\n\r,#\"", rune(sdl[end])) && strings.IndexByte(sdlPunctuators, sdl[end]) < 0 {
+				end++
+			}
+			tokens = append(tokens, sdl[i:end])
+			i = end
+		}
+	}
+
+	return tokens
+}
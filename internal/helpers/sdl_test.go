@@ -0,0 +1,23 @@
+package helpers
+
+import "testing"
+
+func TestSDLEqual(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want bool
+	}{
+		{"type Query { a: Int! }", "type Query {\n  a: Int!\n}\n", true},
+		{"# comment\ntype Query { a(x: Int, y: Int): Int }", "type Query {\n  a(x: Int y: Int): Int\n}", true},
+		{`type Query { a: Int @deprecated(reason: "b  c") }`, `type Query { a: Int @deprecated(reason: "b c") }`, false},
+		{"\"\"\"\nDocs\n\"\"\"\ntype Query { a: Int }", "\"\"\"\nDocs\n\"\"\"\n\ntype Query { a: Int }", true},
+		{"type Query { a: Int }", "type Query { a: String }", false},
+		{"type Query { a: Int }", "type Query { a: Int b: Int }", false},
+	}
+
+	for _, c := range cases {
+		if got := SDLEqual(c.a, c.b); got != c.want {
+			t.Errorf("SDLEqual(%q, %q) = %t, want %t", c.a, c.b, got, c.want)
+		}
+	}
+}
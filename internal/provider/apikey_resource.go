@@ -6,65 +6,41 @@ package provider
 import (
 	"context"
 	"fmt"
-	"io/ioutil"
-	"net/http"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/client"
-	"github.com/segmentio/encoding/json"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
-var _ resource.Resource = &ExampleResource{}
-var _ resource.ResourceWithImportState = &ExampleResource{}
+var _ resource.Resource = &ApiKeyResource{}
+var _ resource.ResourceWithImportState = &ApiKeyResource{}
 
 func NewApiKeyResource() resource.Resource {
 	return &ApiKeyResource{}
 }
 
-type Data struct {
-	Service Service `json:"service"`
-}
-
-type Service struct {
-	NewKey NewKey `json:"newKey"`
-}
-
-type NewKey struct {
-	KeyName string `json:"keyName"`
-	ID      string `json:"id"`
-	Token   string `json:"token"`
-}
-
 // ApiKeyResource defines the resource implementation.
 type ApiKeyResource struct {
 	client *client.Client
 }
 
-type ApiKeyResponse struct {
-	Data struct {
-		Service struct {
-			NewKey struct {
-				KeyName string `json:"keyName"`
-				ID      string `json:"id"`
-				Token   string `json:"token"`
-			} `json:"newKey"`
-		} `json:"service"`
-	} `json:"data"`
-}
-
 // ApiKeyResourceModel describes the resource data model.
 type ApiKeyResourceModel struct {
+	Id      types.String `tfsdk:"id"`
 	GraphId types.String `tfsdk:"graph_id"`
 	KeyName types.String `tfsdk:"key_name"`
-	//Role    types.String `tfsdk:"role"`
-	Token types.String `tfsdk:"token"`
+	Role    types.String `tfsdk:"role"`
+	Token   types.String `tfsdk:"token"`
 }
 
 func (r *ApiKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -77,21 +53,52 @@ func (r *ApiKeyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 		MarkdownDescription: "API key resource",
 
 		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "the id of the api key",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"graph_id": schema.StringAttribute{
-				MarkdownDescription: "the id of the graph that the key is for",
+				MarkdownDescription: "the id of the graph that the key is for. Changing this forces a new key to be created.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"key_name": schema.StringAttribute{
 				MarkdownDescription: "the name of the api key",
 				Required:            true,
 			},
-			// "role": schema.StringAttribute{
-			// 	MarkdownDescription: "the role assigned to the key",
-			// 	Required:            true,
-			// },
+			"role": schema.StringAttribute{
+				MarkdownDescription: "the role assigned to the key, one of `" + strings.Join(client.ApiKeyRoles, "`, `") + "`. " +
+					"Defaults to `" + client.DefaultApiKeyRole + "`. Changing this forces a new key to be created.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(client.DefaultApiKeyRole),
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.ApiKeyRoles...),
+				},
+				PlanModifiers: []planmodifier.String{
+					// Keys created before roles were supported have no role in
+					// state, and replacing them would revoke live tokens.
+					stringplanmodifier.RequiresReplaceIf(
+						func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = !req.StateValue.IsNull()
+						},
+						"Changing the role of a key forces a new key to be created, unless the key has no role in state yet.",
+						"Changing the role of a key forces a new key to be created, unless the key has no role in state yet.",
+					),
+				},
+			},
 			"token": schema.StringAttribute{
 				MarkdownDescription: "the token of the key",
 				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 		},
 	}
@@ -108,7 +115,7 @@ func (r *ApiKeyResource) Configure(ctx context.Context, req resource.ConfigureRe
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -127,42 +134,32 @@ func (r *ApiKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	keyName := data.KeyName.ValueString()
-	graphId := data.GraphId.ValueString()
-
-	url := "https://graphql.api.apollographql.com/api/graphql"
-	method := "POST"
-	payload := strings.NewReader("{\"query\":\"mutation Service($id: ID!, $keyName: String!) {\\n\\t\\tservice(id: $id) {\\n\\t\\t\\tnewKey(keyName: $keyName) {\\n\\t\\t\\t\\tkeyName\\n\\t\\t\\t\\tid\\n\\t\\t\\t\\ttoken\\n\\t\\t\\t}\\n\\t\\t}\\n\\t}\",\"variables\":{\"id\":\"" + graphId + "\",\"keyName\":\"" + keyName + "\"}}")
-	client := &http.Client{}
-	request, err := http.NewRequest(method, url, payload)
+	key, err := r.client.CreateApiKey(ctx, data.GraphId.ValueString(), data.KeyName.ValueString(), data.Role.ValueString())
 	if err != nil {
-		fmt.Println(err)
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create API key, got error: %s", err))
 		return
 	}
-	request.Header.Add("x-api-key", r.client.ApiKey)
-	request.Header.Add("Content-Type", "application/json")
-	res, err := client.Do(request)
-	if err != nil {
-		fmt.Println(err)
+	if key.Token == "" {
+		// A key without a token is useless to the configuration, so don't
+		// leave it behind in Apollo either.
+		if err := r.client.RemoveApiKey(ctx, data.GraphId.ValueString(), key.ID); err != nil && !client.IsNotFound(err) {
+			resp.Diagnostics.AddWarning("Client Error", fmt.Sprintf("Unable to revoke API key %s after it was created without a token, got error: %s", key.ID, err))
+		}
+		resp.Diagnostics.AddError("Client Error", "Unable to create API key, the Apollo API did not return a token for the new key.")
 		return
 	}
-	defer res.Body.Close()
-	body, err := ioutil.ReadAll(res.Body)
-	if err != nil {
-		fmt.Println(err)
-		return
-	}
-	var apiKeyData ApiKeyResponse
-	json.Unmarshal(body, &apiKeyData)
+	ctx = tflog.MaskAllFieldValuesStrings(ctx, key.Token)
+	ctx = tflog.MaskMessageStrings(ctx, key.Token)
 
-	ctx = tflog.SetField(ctx, "lookie", string(body))
-	ctx = tflog.SetField(ctx, "lookie2", apiKeyData.Data.Service.NewKey.Token)
-	fmt.Println(string(body))
-	data.Token = basetypes.NewStringValue(apiKeyData.Data.Service.NewKey.Token)
+	data.Id = types.StringValue(key.ID)
+	data.Token = types.StringValue(key.Token)
+	if key.Role != "" {
+		data.Role = types.StringValue(key.Role)
+	}
 
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
-	tflog.Trace(ctx, "created an apikey")
+	tflog.Trace(ctx, "created an apikey", map[string]interface{}{"graph_id": data.GraphId.ValueString(), "id": key.ID})
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -178,35 +175,64 @@ func (r *ApiKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	// If applicable, this is a great opportunity to initialize any necessary
-	// provider client data and make a call using it.
-	// httpResp, err := r.client.Do(httpReq)
-	// if err != nil {
-	//     resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ApiKey, got error: %s", err))
-	//     return
-	// }
+	if token := data.Token.ValueString(); token != "" {
+		ctx = tflog.MaskAllFieldValuesStrings(ctx, token)
+	}
+
+	var key *client.ApiKey
+	var err error
+	if data.Id.IsNull() || data.Id.ValueString() == "" {
+		// State written before key IDs were stored only has the name.
+		key, err = r.client.GetApiKeyByName(ctx, data.GraphId.ValueString(), data.KeyName.ValueString())
+	} else {
+		key, err = r.client.GetApiKey(ctx, data.GraphId.ValueString(), data.Id.ValueString())
+	}
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "api key no longer exists, removing from state", map[string]interface{}{"graph_id": data.GraphId.ValueString(), "id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read API key, got error: %s", err))
+		return
+	}
+
+	// Tokens are only returned when a key is created, so the one in state is
+	// kept.
+	data.Id = types.StringValue(key.ID)
+	data.KeyName = types.StringValue(key.KeyName)
+	if key.Role != "" {
+		data.Role = types.StringValue(key.Role)
+	} else if data.Role.IsNull() {
+		data.Role = types.StringValue(client.DefaultApiKeyRole)
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ApiKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data ApiKeyResourceModel
+	var data, state ApiKeyResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and prior state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// If applicable, this is a great opportunity to initialize any necessary
-	// provider client data and make a call using it.
-	// httpResp, err := r.client.Do(httpReq)
-	// if err != nil {
-	//     resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update ApiKey, got error: %s", err))
-	//     return
-	// }
+	if !data.KeyName.Equal(state.KeyName) {
+		key, err := r.client.RenameApiKey(ctx, state.GraphId.ValueString(), state.Id.ValueString(), data.KeyName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to rename API key, got error: %s", err))
+			return
+		}
+		data.KeyName = types.StringValue(key.KeyName)
+	}
+
+	data.Id = state.Id
+	data.Token = state.Token
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -222,15 +248,23 @@ func (r *ApiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	// If applicable, this is a great opportunity to initialize any necessary
-	// provider client data and make a call using it.
-	// httpResp, err := r.client.Do(httpReq)
-	// if err != nil {
-	//     resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete ApiKey, got error: %s", err))
-	//     return
-	// }
+	err := r.client.RemoveApiKey(ctx, data.GraphId.ValueString(), data.Id.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to revoke API key, got error: %s", err))
+		return
+	}
 }
 
 func (r *ApiKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	graphId, keyId, ok := strings.Cut(req.ID, "/")
+	if !ok || graphId == "" || keyId == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <graph_id>/<key_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("graph_id"), graphId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), keyId)...)
 }
@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/client"
+)
+
+func TestAccApiKeyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckApiKeyDestroy,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccApiKeyResourceConfig("tf-acc-key"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_apikey.test", "key_name", "tf-acc-key"),
+					resource.TestCheckResourceAttrPair("apollo_apikey.test", "graph_id", "apollo_graph.test", "graph_id"),
+					resource.TestCheckResourceAttrSet("apollo_apikey.test", "id"),
+					resource.TestCheckResourceAttr("apollo_apikey.test", "role", "GRAPH_ADMIN"),
+					resource.TestCheckResourceAttrSet("apollo_apikey.test", "token"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "apollo_apikey.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					attributes := s.RootModule().Resources["apollo_apikey.test"].Primary.Attributes
+					return attributes["graph_id"] + "/" + attributes["id"], nil
+				},
+				// Tokens are only returned in full when the key is created.
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccApiKeyResourceConfig("tf-acc-key-renamed"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("apollo_apikey.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_apikey.test", "key_name", "tf-acc-key-renamed"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccApiKeyResource_role(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckApiKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccApiKeyResourceConfigWithRole("tf-acc-key", "SUPERUSER"),
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+			{
+				Config: testAccApiKeyResourceConfigWithRole("tf-acc-key", "CONSUMER"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_apikey.test", "role", "CONSUMER"),
+				),
+			},
+			// Changing the role replaces the key
+			{
+				Config: testAccApiKeyResourceConfigWithRole("tf-acc-key", "OBSERVER"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("apollo_apikey.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_apikey.test", "role", "OBSERVER"),
+				),
+			},
+		},
+	})
+}
+
+func testAccApiKeyResourceConfig(keyName string) string {
+	return fmt.Sprintf(`
+resource "apollo_graph" "test" {
+  org_id     = %[1]q
+  graph_name = "tf-acc-apikey-graph"
+}
+
+resource "apollo_apikey" "test" {
+  graph_id = apollo_graph.test.graph_id
+  key_name = %[2]q
+}
+`, os.Getenv("APOLLO_ORG_ID"), keyName)
+}
+
+// testAccCheckApiKeyDestroy verifies that destroyed keys were revoked in Apollo.
+func testAccCheckApiKeyDestroy(s *terraform.State) error {
+	apollo := &client.Client{ApiKey: os.Getenv("APOLLO_KEY"), Endpoint: os.Getenv("APOLLO_ENDPOINT")}
+	apollo.Init()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "apollo_apikey" {
+			continue
+		}
+
+		_, err := apollo.GetApiKey(context.Background(), rs.Primary.Attributes["graph_id"], rs.Primary.Attributes["id"])
+		if err == nil {
+			return fmt.Errorf("api key %s still exists", rs.Primary.Attributes["id"])
+		}
+		if !client.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccApiKeyResourceConfigWithRole(keyName, role string) string {
+	return fmt.Sprintf(`
+resource "apollo_graph" "test" {
+  org_id     = %[1]q
+  graph_name = "tf-acc-apikey-graph"
+}
+
+resource "apollo_apikey" "test" {
+  graph_id = apollo_graph.test.graph_id
+  key_name = %[2]q
+  role     = %[3]q
+}
+`, os.Getenv("APOLLO_ORG_ID"), keyName, role)
+}
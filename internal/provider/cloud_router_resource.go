@@ -0,0 +1,465 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/client"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/helpers"
+)
+
+// routerSecretNamePattern matches the names of router secrets, which the
+// router exposes as environment variables.
+var routerSecretNamePattern = regexp.MustCompile(`^[A-Z_][A-Z0-9_]*$`)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CloudRouterResource{}
+var _ resource.ResourceWithConfigValidators = &CloudRouterResource{}
+var _ resource.ResourceWithValidateConfig = &CloudRouterResource{}
+var _ resource.ResourceWithImportState = &CloudRouterResource{}
+
+func NewCloudRouterResource() resource.Resource {
+	return &CloudRouterResource{}
+}
+
+// CloudRouterResource defines the resource implementation.
+type CloudRouterResource struct {
+	client *client.Client
+}
+
+// CloudRouterResourceModel describes the resource data model.
+type CloudRouterResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	GraphId        types.String `tfsdk:"graph_id"`
+	Variant        types.String `tfsdk:"variant"`
+	Config         types.String `tfsdk:"config"`
+	ConfigYaml     types.String `tfsdk:"config_yaml"`
+	Secrets        types.Map    `tfsdk:"secrets"`
+	VersionChannel types.String `tfsdk:"version_channel"`
+	CustomDomain   types.String `tfsdk:"custom_domain"`
+	Status         types.String `tfsdk:"status"`
+	EndpointUrl    types.String `tfsdk:"endpoint_url"`
+}
+
+func (r *CloudRouterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_router"
+}
+
+func (r *CloudRouterResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Apollo-managed router serving a graph variant",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Graph ref of the variant, in the form `<graph_id>@<variant>`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"graph_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the graph. Changing this forces a new router to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"variant": schema.StringAttribute{
+				MarkdownDescription: "Name of the variant served by the router. Defaults to `current`. Changing this forces a new router to be created.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("current"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"config": schema.StringAttribute{
+				MarkdownDescription: "Router configuration as an HCL object encoded with `jsonencode`. Exactly one of `config` and `config_yaml` must be set.",
+				Optional:            true,
+			},
+			"config_yaml": schema.StringAttribute{
+				MarkdownDescription: "Router configuration as raw YAML. Exactly one of `config` and `config_yaml` must be set.",
+				Optional:            true,
+			},
+			"secrets": schema.MapAttribute{
+				MarkdownDescription: "Secrets exposed to the router as environment variables, keyed by variable name. Reference them in the configuration with `${env.NAME}`. Apollo never returns secret values, so only removed secrets are detected. Do not manage the same names with `apollo_router_secret`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.KeysAre(stringvalidator.RegexMatches(routerSecretNamePattern, "must be an environment variable name of uppercase letters, digits and underscores")),
+				},
+			},
+			"version_channel": schema.StringAttribute{
+				MarkdownDescription: "Router release channel, such as `1.x` or `2.x`. Defaults to the channel Apollo picks for new routers.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"custom_domain": schema.StringAttribute{
+				MarkdownDescription: "Custom domain the router is served on, in addition to its Apollo endpoint",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the router, such as `CREATING`, `RUNNING` or `UPDATING`",
+				Computed:            true,
+			},
+			"endpoint_url": schema.StringAttribute{
+				MarkdownDescription: "URL clients send operations to",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CloudRouterResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("config"),
+			path.MatchRoot("config_yaml"),
+		),
+	}
+}
+
+func (r *CloudRouterResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CloudRouterResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for attribute, value := range map[string]types.String{"config": data.Config, "config_yaml": data.ConfigYaml} {
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		if _, err := helpers.ParseRouterConfig(value.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(attribute), "Invalid Router Configuration", err.Error())
+		}
+	}
+}
+
+func (r *CloudRouterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CloudRouterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CloudRouterResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, diags := data.input()
+	resp.Diagnostics.Append(diags...)
+	var secrets map[string]string
+	if !data.Secrets.IsNull() {
+		resp.Diagnostics.Append(data.Secrets.ElementsAs(ctx, &secrets, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	graphId, variant := data.GraphId.ValueString(), data.Variant.ValueString()
+
+	// Secrets are set first so that the router can resolve them from its
+	// first start.
+	if len(secrets) > 0 {
+		if err := r.client.SetRouterSecrets(ctx, graphId, variant, secrets); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set router secrets, got error: %s", err))
+			return
+		}
+	}
+
+	router, err := r.client.CreateCloudRouter(ctx, graphId, variant, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create cloud router, got error: %s", err))
+
+		// Nothing tracks the secrets without the router, so remove them again.
+		if len(secrets) > 0 {
+			names := make([]string, 0, len(secrets))
+			for name := range secrets {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			if err := r.client.RemoveRouterSecrets(ctx, graphId, variant, names); err != nil && !client.IsNotFound(err) {
+				resp.Diagnostics.AddWarning("Client Error", fmt.Sprintf("Unable to remove router secrets %s after the router could not be created, got error: %s", strings.Join(names, ", "), err))
+			}
+		}
+		return
+	}
+
+	data.Id = types.StringValue(client.VariantRef(graphId, variant))
+	resp.Diagnostics.Append(data.refresh(ctx, router)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a cloud router", map[string]interface{}{"id": data.Id.ValueString(), "status": router.Status})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CloudRouterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CloudRouterResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	router, err := r.client.GetCloudRouter(ctx, data.GraphId.ValueString(), data.Variant.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "cloud router no longer exists, removing from state", map[string]interface{}{"id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cloud router, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, router)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CloudRouterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state CloudRouterResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, diags := data.input()
+	resp.Diagnostics.Append(diags...)
+	var planned, prior map[string]string
+	if !data.Secrets.IsNull() {
+		resp.Diagnostics.Append(data.Secrets.ElementsAs(ctx, &planned, false)...)
+	}
+	if !state.Secrets.IsNull() {
+		resp.Diagnostics.Append(state.Secrets.ElementsAs(ctx, &prior, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	graphId, variant := data.GraphId.ValueString(), data.Variant.ValueString()
+
+	changed := map[string]string{}
+	for name, value := range planned {
+		if current, ok := prior[name]; !ok || current != value {
+			changed[name] = value
+		}
+	}
+	var removed []string
+	for name := range prior {
+		if _, ok := planned[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
+
+	if len(changed) > 0 {
+		if err := r.client.SetRouterSecrets(ctx, graphId, variant, changed); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set router secrets, got error: %s", err))
+			return
+		}
+	}
+
+	router, err := r.client.UpdateCloudRouter(ctx, graphId, variant, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update cloud router, got error: %s", err))
+		return
+	}
+
+	// Secrets are removed once the new configuration no longer references
+	// them.
+	if len(removed) > 0 {
+		if err := r.client.RemoveRouterSecrets(ctx, graphId, variant, removed); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove router secrets, got error: %s", err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, router)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CloudRouterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CloudRouterResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DestroyCloudRouter(ctx, data.GraphId.ValueString(), data.Variant.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to destroy cloud router, got error: %s", err))
+		return
+	}
+}
+
+func (r *CloudRouterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	graphId, name, err := client.ParseVariantRef(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("graph_id"), graphId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("variant"), name)...)
+}
+
+// input converts the model into the router configuration sent to Apollo. The
+// configuration is always sent as YAML.
+func (m *CloudRouterResourceModel) input() (client.CloudRouterInput, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	config := m.ConfigYaml.ValueString()
+	if !m.Config.IsNull() {
+		config = m.Config.ValueString()
+	}
+
+	yaml, err := helpers.RouterConfigYAML(config)
+	if err != nil {
+		diags.AddError("Invalid Router Configuration", err.Error())
+	}
+
+	input := client.CloudRouterInput{
+		RouterConfig: yaml,
+		CustomDomain: m.CustomDomain.ValueStringPointer(),
+	}
+	if !m.VersionChannel.IsNull() && !m.VersionChannel.IsUnknown() {
+		input.Version = m.VersionChannel.ValueStringPointer()
+	}
+
+	return input, diags
+}
+
+// refresh copies the API representation of the router into the model. The
+// configuration is only replaced when its settings differ from the configured
+// ones, so formatting and key order do not cause diffs. Secrets keep their
+// configured values and are dropped when they no longer exist.
+func (m *CloudRouterResourceModel) refresh(ctx context.Context, router *client.CloudRouter) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Id = types.StringValue(client.VariantRef(m.GraphId.ValueString(), m.Variant.ValueString()))
+
+	switch {
+	case !m.Config.IsNull():
+		if !helpers.RouterConfigEqual(m.Config.ValueString(), router.RouterConfig) {
+			config, err := helpers.RouterConfigJSON(router.RouterConfig)
+			if err != nil {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to decode router configuration returned by Apollo: %s", err))
+				return diags
+			}
+			m.Config = types.StringValue(config)
+		}
+	case m.ConfigYaml.IsNull() || !helpers.RouterConfigEqual(m.ConfigYaml.ValueString(), router.RouterConfig):
+		m.ConfigYaml = types.StringValue(router.RouterConfig)
+	}
+
+	m.VersionChannel = types.StringValue(router.Version)
+	m.CustomDomain = types.StringNull()
+	if router.CustomDomain != nil && *router.CustomDomain != "" {
+		m.CustomDomain = types.StringValue(*router.CustomDomain)
+	}
+	m.Status = types.StringValue(router.Status)
+	m.EndpointUrl = types.StringValue(router.RouterURL)
+
+	if !m.Secrets.IsNull() && !m.Secrets.IsUnknown() {
+		var secrets map[string]string
+		diags.Append(m.Secrets.ElementsAs(ctx, &secrets, false)...)
+
+		existing := map[string]string{}
+		for _, name := range router.SecretNames() {
+			if value, ok := secrets[name]; ok {
+				existing[name] = value
+			}
+		}
+
+		var d diag.Diagnostics
+		if len(existing) > 0 {
+			m.Secrets, d = types.MapValueFrom(ctx, types.StringType, existing)
+		} else {
+			m.Secrets = types.MapNull(types.StringType)
+		}
+		diags.Append(d...)
+	}
+
+	return diags
+}
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCloudRouterResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if os.Getenv("APOLLO_CLOUD_ROUTER_GRAPH_ID") == "" {
+				t.Skip("APOLLO_CLOUD_ROUTER_GRAPH_ID must be set to a graph with a published supergraph to run cloud router acceptance tests")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCloudRouterResourceConfig("false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_cloud_router.test", "variant", "current"),
+					resource.TestCheckResourceAttr("apollo_cloud_router.test", "secrets.%", "1"),
+					resource.TestCheckResourceAttrSet("apollo_cloud_router.test", "status"),
+					resource.TestCheckResourceAttrSet("apollo_cloud_router.test", "endpoint_url"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "apollo_cloud_router.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Configuration is imported as YAML and secrets are write-only.
+				ImportStateVerifyIgnore: []string{"config", "config_yaml", "secrets", "status"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccCloudRouterResourceConfig("true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_cloud_router.test", "config", `{"include_subgraph_errors":{"all":true}}`),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCloudRouterResourceConfig(includeErrors string) string {
+	return fmt.Sprintf(`
+resource "apollo_cloud_router" "test" {
+  graph_id = %[1]q
+
+  config = jsonencode({
+    include_subgraph_errors = {
+      all = %[2]s
+    }
+  })
+
+  secrets = {
+    TF_ACC_TOKEN = "tf-acc-secret"
+  }
+}
+`, os.Getenv("APOLLO_CLOUD_ROUTER_GRAPH_ID"), includeErrors)
+}
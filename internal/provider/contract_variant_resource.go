@@ -0,0 +1,350 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ContractVariantResource{}
+var _ resource.ResourceWithImportState = &ContractVariantResource{}
+var _ resource.ResourceWithModifyPlan = &ContractVariantResource{}
+
+func NewContractVariantResource() resource.Resource {
+	return &ContractVariantResource{}
+}
+
+// ContractVariantResource defines the resource implementation.
+type ContractVariantResource struct {
+	client *client.Client
+}
+
+// ContractVariantResourceModel describes the resource data model.
+type ContractVariantResourceModel struct {
+	Id                   types.String `tfsdk:"id"`
+	GraphId              types.String `tfsdk:"graph_id"`
+	Name                 types.String `tfsdk:"name"`
+	SourceVariant        types.String `tfsdk:"source_variant"`
+	IncludeTags          types.Set    `tfsdk:"include_tags"`
+	ExcludeTags          types.Set    `tfsdk:"exclude_tags"`
+	HideUnreachableTypes types.Bool   `tfsdk:"hide_unreachable_types"`
+	LaunchId             types.String `tfsdk:"launch_id"`
+	LaunchStatus         types.String `tfsdk:"launch_status"`
+	BuildErrors          types.List   `tfsdk:"build_errors"`
+}
+
+func (r *ContractVariantResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_contract_variant"
+}
+
+func (r *ContractVariantResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	emptyTags := types.SetValueMust(types.StringType, nil)
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Contract variant resource. Contracts require an organization whose plan enables them, such as an enterprise plan.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Graph ref of the contract variant, in the form `<graph_id>@<name>`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"graph_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the graph the contract variant belongs to. Changing this forces a new contract variant to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the contract variant. Changing this forces a new contract variant to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_variant": schema.StringAttribute{
+				MarkdownDescription: "Name of the variant of the same graph the contract is derived from",
+				Required:            true,
+			},
+			"include_tags": schema.SetAttribute{
+				MarkdownDescription: "`@tag` names to include. When empty, everything not excluded is included.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(emptyTags),
+			},
+			"exclude_tags": schema.SetAttribute{
+				MarkdownDescription: "`@tag` names to exclude",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(emptyTags),
+			},
+			"hide_unreachable_types": schema.BoolAttribute{
+				MarkdownDescription: "Whether types that are unreachable from the root operation types are removed. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"launch_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the latest launch of the contract variant",
+				Computed:            true,
+			},
+			"launch_status": schema.StringAttribute{
+				MarkdownDescription: "Status of the latest launch of the contract variant",
+				Computed:            true,
+			},
+			"build_errors": schema.ListAttribute{
+				MarkdownDescription: "Errors that failed the build of the latest launch",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *ContractVariantResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ContractVariantResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Destroying a contract never requires enterprise features.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	if !r.client.EnterPriseEnabled {
+		resp.Diagnostics.AddError(
+			"Enterprise Plan Required",
+			"Contract variants are only available to organizations whose plan enables contracts, and none of the organizations "+
+				"the configured API key belongs to has one.",
+		)
+	}
+}
+
+func (r *ContractVariantResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ContractVariantResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configured, diags := r.upsert(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if !configured {
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a contract variant", map[string]interface{}{"id": data.Id.ValueString()})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(buildErrorWarnings("Contract Build Error", data.BuildErrors)...)
+}
+
+func (r *ContractVariantResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ContractVariantResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	contract, err := r.client.GetContractVariant(ctx, data.GraphId.ValueString(), data.Name.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "contract variant no longer exists, removing from state", map[string]interface{}{"id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read contract variant, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, contract)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ContractVariantResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ContractVariantResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configured, diags := r.upsert(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if !configured {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(buildErrorWarnings("Contract Build Error", data.BuildErrors)...)
+}
+
+func (r *ContractVariantResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ContractVariantResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteVariant(ctx, data.GraphId.ValueString(), data.Name.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete contract variant, got error: %s", err))
+		return
+	}
+}
+
+func (r *ContractVariantResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	graphId, name, err := client.ParseVariantRef(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("graph_id"), graphId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+// upsert sends the planned contract configuration to Apollo and refreshes the
+// model with the resulting launch. It reports whether the contract was
+// configured, in which case the model should be saved even if reading the
+// launch back failed.
+func (r *ContractVariantResource) upsert(ctx context.Context, data *ContractVariantResourceModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	filter := client.ContractFilter{
+		HideUnreachableTypes: data.HideUnreachableTypes.ValueBool(),
+	}
+	diags.Append(data.IncludeTags.ElementsAs(ctx, &filter.Include, false)...)
+	diags.Append(data.ExcludeTags.ElementsAs(ctx, &filter.Exclude, false)...)
+	if diags.HasError() {
+		return false, diags
+	}
+	sort.Strings(filter.Include)
+	sort.Strings(filter.Exclude)
+
+	err := r.client.UpsertContractVariant(ctx, data.GraphId.ValueString(), data.Name.ValueString(), data.SourceVariant.ValueString(), filter)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to configure contract variant, got error: %s", err))
+		return false, diags
+	}
+
+	// The contract exists from here on, so the launch attributes are cleared
+	// rather than left unknown in case it cannot be read back.
+	data.Id = types.StringValue(client.VariantRef(data.GraphId.ValueString(), data.Name.ValueString()))
+	data.LaunchId = types.StringNull()
+	data.LaunchStatus = types.StringNull()
+	data.BuildErrors = types.ListNull(types.StringType)
+
+	contract, err := r.client.GetContractVariant(ctx, data.GraphId.ValueString(), data.Name.ValueString())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read contract variant, got error: %s", err))
+		return true, diags
+	}
+
+	diags.Append(data.refresh(ctx, contract)...)
+	return true, diags
+}
+
+// refresh copies the state of contract into the model.
+func (m *ContractVariantResourceModel) refresh(ctx context.Context, contract *client.ContractVariant) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	m.Id = types.StringValue(client.VariantRef(m.GraphId.ValueString(), contract.Name))
+	m.Name = types.StringValue(contract.Name)
+	if contract.SourceVariant != nil {
+		m.SourceVariant = types.StringValue(contract.SourceVariant.Name)
+	}
+
+	m.IncludeTags, d = types.SetValueFrom(ctx, types.StringType, nonNilStrings(contract.ContractFilterConfig.Include))
+	diags.Append(d...)
+	m.ExcludeTags, d = types.SetValueFrom(ctx, types.StringType, nonNilStrings(contract.ContractFilterConfig.Exclude))
+	diags.Append(d...)
+	m.HideUnreachableTypes = types.BoolValue(contract.ContractFilterConfig.HideUnreachableTypes)
+
+	m.LaunchId = types.StringNull()
+	m.LaunchStatus = types.StringNull()
+	if launch := contract.LatestLaunch; launch != nil {
+		m.LaunchId = types.StringValue(launch.ID)
+		m.LaunchStatus = types.StringValue(launch.Status)
+	}
+	m.BuildErrors, d = types.ListValueFrom(ctx, types.StringType, nonNilStrings(contract.LatestLaunch.BuildErrors()))
+	diags.Append(d...)
+
+	return diags
+}
+
+// buildErrorWarnings reports the build errors of a launch as warnings with the
+// given summary. The configuration itself was saved, so they do not fail the
+// apply.
+func buildErrorWarnings(summary string, buildErrors types.List) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, e := range buildErrors.Elements() {
+		if message, ok := e.(types.String); ok {
+			diags.AddWarning(summary, message.ValueString())
+		}
+	}
+	return diags
+}
+
+// nonNilStrings returns s, or an empty slice if s is nil, so that it converts
+// to an empty rather than a null collection.
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccContractVariantResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if os.Getenv("APOLLO_ENTERPRISE") == "" {
+				t.Skip("APOLLO_ENTERPRISE must be set to run contract acceptance tests")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccContractVariantResourceConfig("public"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_contract_variant.test", "source_variant", "current"),
+					resource.TestCheckResourceAttr("apollo_contract_variant.test", "include_tags.#", "1"),
+					resource.TestCheckTypeSetElemAttr("apollo_contract_variant.test", "include_tags.*", "public"),
+					resource.TestCheckResourceAttr("apollo_contract_variant.test", "hide_unreachable_types", "true"),
+					resource.TestCheckResourceAttrSet("apollo_contract_variant.test", "launch_id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "apollo_contract_variant.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"launch_status", "build_errors"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccContractVariantResourceConfig("partner"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("apollo_contract_variant.test", "include_tags.*", "partner"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccContractVariantResourceConfig(tag string) string {
+	return fmt.Sprintf(`
+resource "apollo_graph" "test" {
+  org_id     = %[1]q
+  graph_name = "tf-acc-contract-graph"
+}
+
+resource "apollo_subgraph" "test" {
+  graph_id    = apollo_graph.test.graph_id
+  name        = "products"
+  routing_url = "https://products.example.com/graphql"
+  sdl         = %[2]q
+}
+
+resource "apollo_contract_variant" "test" {
+  graph_id               = apollo_subgraph.test.graph_id
+  name                   = "contract"
+  source_variant         = apollo_subgraph.test.variant
+  include_tags           = [%[3]q]
+  hide_unreachable_types = true
+}
+`, os.Getenv("APOLLO_ORG_ID"), testAccSubgraphSdl, tag)
+}
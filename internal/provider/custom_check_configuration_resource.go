@@ -0,0 +1,243 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CustomCheckConfigurationResource{}
+var _ resource.ResourceWithImportState = &CustomCheckConfigurationResource{}
+
+func NewCustomCheckConfigurationResource() resource.Resource {
+	return &CustomCheckConfigurationResource{}
+}
+
+// CustomCheckConfigurationResource defines the resource implementation.
+type CustomCheckConfigurationResource struct {
+	client *client.Client
+}
+
+// CustomCheckConfigurationResourceModel describes the resource data model.
+type CustomCheckConfigurationResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	GraphId     types.String `tfsdk:"graph_id"`
+	Variant     types.String `tfsdk:"variant"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	WebhookUrl  types.String `tfsdk:"webhook_url"`
+	SecretToken types.String `tfsdk:"secret_token"`
+}
+
+func (r *CustomCheckConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_check_configuration"
+}
+
+func (r *CustomCheckConfigurationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Custom schema check webhook of a graph variant",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Graph ref of the variant, in the form `<graph_id>@<variant>`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"graph_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the graph. Changing this forces a new resource to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"variant": schema.StringAttribute{
+				MarkdownDescription: "Name of the variant whose checks call the webhook. Defaults to `current`. Changing this forces a new resource to be created.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("current"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether checks run the custom check step. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"webhook_url": schema.StringAttribute{
+				MarkdownDescription: "HTTPS URL Apollo sends check payloads to",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^https://`), "must be an https URL"),
+				},
+			},
+			"secret_token": schema.StringAttribute{
+				MarkdownDescription: "Secret used to sign payloads with HMAC-SHA256. Apollo never returns it, so changes made outside Terraform are not detected.",
+				Required:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *CustomCheckConfigurationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CustomCheckConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CustomCheckConfigurationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configuration, err := r.client.UpdateCustomCheckConfiguration(ctx, data.GraphId.ValueString(), data.Variant.ValueString(), data.Enabled.ValueBool(), data.WebhookUrl.ValueString(), data.SecretToken.ValueStringPointer())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to configure custom checks, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(client.VariantRef(data.GraphId.ValueString(), data.Variant.ValueString()))
+	data.refresh(configuration)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "configured custom checks", map[string]interface{}{"id": data.Id.ValueString()})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomCheckConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CustomCheckConfigurationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configuration, err := r.client.GetCustomCheckConfiguration(ctx, data.GraphId.ValueString(), data.Variant.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "custom check configuration no longer exists, removing from state", map[string]interface{}{"id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read custom check configuration, got error: %s", err))
+		return
+	}
+
+	data.refresh(configuration)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomCheckConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state CustomCheckConfigurationResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only send the secret when it changed so Apollo keeps the current one.
+	var secretToken *string
+	if !data.SecretToken.Equal(state.SecretToken) {
+		secretToken = data.SecretToken.ValueStringPointer()
+	}
+
+	configuration, err := r.client.UpdateCustomCheckConfiguration(ctx, data.GraphId.ValueString(), data.Variant.ValueString(), data.Enabled.ValueBool(), data.WebhookUrl.ValueString(), secretToken)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update custom check configuration, got error: %s", err))
+		return
+	}
+
+	data.refresh(configuration)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomCheckConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CustomCheckConfigurationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteCustomCheckConfiguration(ctx, data.GraphId.ValueString(), data.Variant.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete custom check configuration, got error: %s", err))
+		return
+	}
+}
+
+func (r *CustomCheckConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	graphId, name, err := client.ParseVariantRef(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("graph_id"), graphId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("variant"), name)...)
+}
+
+// refresh copies the API representation of the webhook into the model. The
+// secret is write-only and keeps its current value.
+func (m *CustomCheckConfigurationResourceModel) refresh(configuration *client.CustomCheckConfiguration) {
+	m.Enabled = types.BoolValue(configuration.Enabled)
+	m.WebhookUrl = types.StringValue(configuration.WebhookURL)
+}
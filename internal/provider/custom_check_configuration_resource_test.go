@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCustomCheckConfigurationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCustomCheckConfigurationResourceConfig("https://example.com/checks", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_custom_check_configuration.test", "variant", "current"),
+					resource.TestCheckResourceAttr("apollo_custom_check_configuration.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("apollo_custom_check_configuration.test", "webhook_url", "https://example.com/checks"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "apollo_custom_check_configuration.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The secret is write-only.
+				ImportStateVerifyIgnore: []string{"secret_token"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccCustomCheckConfigurationResourceConfig("https://example.com/checks/v2", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_custom_check_configuration.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("apollo_custom_check_configuration.test", "webhook_url", "https://example.com/checks/v2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCustomCheckConfigurationResourceConfig(url string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "apollo_graph" "test" {
+  org_id     = %[1]q
+  graph_name = "tf-acc-custom-check-graph"
+}
+
+resource "apollo_custom_check_configuration" "test" {
+  graph_id     = apollo_graph.test.graph_id
+  enabled      = %[3]t
+  webhook_url  = %[2]q
+  secret_token = "tf-acc-secret"
+}
+`, os.Getenv("APOLLO_ORG_ID"), url, enabled)
+}
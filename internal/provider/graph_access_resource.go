@@ -0,0 +1,251 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GraphAccessResource{}
+var _ resource.ResourceWithConfigValidators = &GraphAccessResource{}
+var _ resource.ResourceWithImportState = &GraphAccessResource{}
+
+func NewGraphAccessResource() resource.Resource {
+	return &GraphAccessResource{}
+}
+
+// GraphAccessResource defines the resource implementation.
+type GraphAccessResource struct {
+	client *client.Client
+}
+
+// GraphAccessResourceModel describes the resource data model.
+type GraphAccessResourceModel struct {
+	Id      types.String `tfsdk:"id"`
+	GraphId types.String `tfsdk:"graph_id"`
+	UserId  types.String `tfsdk:"user_id"`
+	TeamId  types.String `tfsdk:"team_id"`
+	Role    types.String `tfsdk:"role"`
+}
+
+func (r *GraphAccessResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_graph_access"
+}
+
+func (r *GraphAccessResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Grants a user or team a role on a single graph. " +
+			"Combine with `hidden_from_uninvited_non_admins` on `apollo_graph` to restrict a graph to the members granted here.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the grant, in the form `<graph_id>/<user|team>/<id>`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"graph_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the graph to grant access to. Changing this forces a new grant to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the user to grant access to, such as the `id` of an `apollo_org_member`. " +
+					"Exactly one of `user_id` and `team_id` must be set. Changing this forces a new grant to be created.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the team to grant access to. " +
+					"Exactly one of `user_id` and `team_id` must be set. Changing this forces a new grant to be created.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Role granted on the graph, one of `" + strings.Join(client.GraphRoles, "`, `") + "`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.GraphRoles...),
+				},
+			},
+		},
+	}
+}
+
+func (r *GraphAccessResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("user_id"),
+			path.MatchRoot("team_id"),
+		),
+	}
+}
+
+func (r *GraphAccessResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GraphAccessResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GraphAccessResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subjectType, subjectId := data.subject()
+	grant, err := r.client.GrantGraphAccess(ctx, data.GraphId.ValueString(), subjectType, subjectId, data.Role.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to grant graph access, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(client.GraphAccessRef(data.GraphId.ValueString(), subjectType, subjectId))
+	data.Role = types.StringValue(grant.Role)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "granted graph access", map[string]interface{}{"id": data.Id.ValueString()})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GraphAccessResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GraphAccessResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subjectType, subjectId := data.subject()
+	grant, err := r.client.GetGraphAccess(ctx, data.GraphId.ValueString(), subjectType, subjectId)
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "graph access grant no longer exists, removing from state", map[string]interface{}{"id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read graph access, got error: %s", err))
+		return
+	}
+
+	data.Role = types.StringValue(grant.Role)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GraphAccessResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state GraphAccessResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Granting again replaces the role the subject had before.
+	subjectType, subjectId := state.subject()
+	grant, err := r.client.GrantGraphAccess(ctx, state.GraphId.ValueString(), subjectType, subjectId, data.Role.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update graph access, got error: %s", err))
+		return
+	}
+
+	data.Id = state.Id
+	data.Role = types.StringValue(grant.Role)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GraphAccessResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GraphAccessResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subjectType, subjectId := data.subject()
+	err := r.client.RevokeGraphAccess(ctx, data.GraphId.ValueString(), subjectType, subjectId)
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to revoke graph access, got error: %s", err))
+		return
+	}
+}
+
+func (r *GraphAccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	graphId, subjectType, subjectId, err := client.ParseGraphAccessRef(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+		return
+	}
+
+	subjectAttribute := "user_id"
+	if subjectType == client.AccessSubjectTeam {
+		subjectAttribute = "team_id"
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), client.GraphAccessRef(graphId, subjectType, subjectId))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("graph_id"), graphId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(subjectAttribute), subjectId)...)
+}
+
+// subject returns the type and ID of the user or team the grant is for.
+func (m *GraphAccessResourceModel) subject() (string, string) {
+	if !m.TeamId.IsNull() {
+		return client.AccessSubjectTeam, m.TeamId.ValueString()
+	}
+	return client.AccessSubjectUser, m.UserId.ValueString()
+}
@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGraphAccessResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if os.Getenv("APOLLO_MEMBER_ID") == "" {
+				t.Skip("APOLLO_MEMBER_ID must be set to the user ID of an existing member to run graph access acceptance tests")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGraphAccessResourceConfig("OBSERVER"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_graph_access.test", "role", "OBSERVER"),
+					resource.TestCheckResourceAttr("apollo_graph_access.test", "user_id", os.Getenv("APOLLO_MEMBER_ID")),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "apollo_graph_access.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccGraphAccessResourceConfig("CONTRIBUTOR"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_graph_access.test", "role", "CONTRIBUTOR"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccGraphAccessResource_subject(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "apollo_graph_access" "test" {
+  graph_id = "my-graph"
+  role     = "OBSERVER"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func testAccGraphAccessResourceConfig(role string) string {
+	return fmt.Sprintf(`
+resource "apollo_graph" "test" {
+  org_id                           = %[1]q
+  graph_name                       = "tf-acc-access-graph"
+  hidden_from_uninvited_non_admins = true
+}
+
+resource "apollo_graph_access" "test" {
+  graph_id = apollo_graph.test.graph_id
+  user_id  = %[2]q
+  role     = %[3]q
+}
+`, os.Getenv("APOLLO_ORG_ID"), os.Getenv("APOLLO_MEMBER_ID"), role)
+}
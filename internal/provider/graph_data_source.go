@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GraphDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &GraphDataSource{}
+
+func NewGraphDataSource() datasource.DataSource {
+	return &GraphDataSource{}
+}
+
+// GraphDataSource defines the data source implementation.
+type GraphDataSource struct {
+	client *client.Client
+}
+
+// GraphDataSourceModel describes the data source data model.
+type GraphDataSourceModel struct {
+	GraphId                      types.String   `tfsdk:"graph_id"`
+	GraphName                    types.String   `tfsdk:"graph_name"`
+	OrgId                        types.String   `tfsdk:"org_id"`
+	OrgName                      types.String   `tfsdk:"org_name"`
+	Title                        types.String   `tfsdk:"title"`
+	Variants                     []types.String `tfsdk:"variants"`
+	HiddenFromUninvitedNonAdmins types.Bool     `tfsdk:"hidden_from_uninvited_non_admins"`
+}
+
+func (d *GraphDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_graph"
+}
+
+func (d *GraphDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Looks up an existing graph by `graph_id`, or by `graph_name` within an `org_id`",
+
+		Attributes: map[string]schema.Attribute{
+			"graph_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the graph. Exactly one of `graph_id` and `graph_name` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"graph_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the graph, as shown in Apollo Studio. Requires `org_id`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("org_id")),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				MarkdownDescription: "Organization ID the graph belongs to. Required when looking up by `graph_name`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"org_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the organization the graph belongs to",
+				Computed:            true,
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Title of the graph",
+				Computed:            true,
+			},
+			"variants": schema.ListAttribute{
+				MarkdownDescription: "Names of the variants of the graph",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"hidden_from_uninvited_non_admins": schema.BoolAttribute{
+				MarkdownDescription: "Whether the graph is hidden from organization members who are neither admins nor invited to it",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GraphDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("graph_id"),
+			path.MatchRoot("graph_name"),
+		),
+	}
+}
+
+func (d *GraphDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *GraphDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GraphDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var graph *client.Graph
+	var err error
+	if !data.GraphId.IsNull() {
+		graph, err = d.client.GetGraph(ctx, data.GraphId.ValueString())
+	} else {
+		graph, err = d.client.FindGraphByTitle(ctx, data.OrgId.ValueString(), data.GraphName.ValueString())
+	}
+	if client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Graph Not Found", "No graph matches the given graph_id or graph_name, or the configured API key cannot access it.")
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read graph, got error: %s", err))
+		return
+	}
+
+	if !data.OrgId.IsNull() && (graph.Account == nil || graph.Account.ID != data.OrgId.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("org_id"),
+			"Graph Not In Organization",
+			fmt.Sprintf("The graph %q does not belong to the organization %q.", graph.ID, data.OrgId.ValueString()),
+		)
+		return
+	}
+
+	data.GraphId = types.StringValue(graph.ID)
+	data.GraphName = types.StringValue(graph.DisplayName())
+	data.Title = types.StringValue(graph.Title)
+	data.HiddenFromUninvitedNonAdmins = types.BoolValue(graph.HiddenFromUninvitedNonAdminAccountMembers)
+	data.OrgName = types.StringNull()
+	if graph.Account != nil {
+		data.OrgName = types.StringValue(graph.Account.Name)
+		data.OrgId = types.StringValue(graph.Account.ID)
+	}
+
+	data.Variants = make([]types.String, 0, len(graph.Variants))
+	for _, variant := range graph.Variants {
+		data.Variants = append(data.Variants, types.StringValue(variant.Name))
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a graph", map[string]interface{}{"graph_id": graph.ID})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGraphDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGraphDataSourceConfigInvalid,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			// Read testing
+			{
+				Config: testAccGraphDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.apollo_graph.by_id", "graph_id", "apollo_graph.test", "graph_id"),
+					resource.TestCheckResourceAttr("data.apollo_graph.by_id", "title", "tf-acc-lookup-graph"),
+					resource.TestCheckResourceAttr("data.apollo_graph.by_id", "org_id", os.Getenv("APOLLO_ORG_ID")),
+					resource.TestCheckResourceAttrPair("data.apollo_graph.by_name", "graph_id", "apollo_graph.test", "graph_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGraphDataSourceConfig() string {
+	return fmt.Sprintf(`
+resource "apollo_graph" "test" {
+  org_id     = %[1]q
+  graph_name = "tf-acc-lookup-graph"
+}
+
+data "apollo_graph" "by_id" {
+  graph_id = apollo_graph.test.graph_id
+}
+
+data "apollo_graph" "by_name" {
+  org_id     = apollo_graph.test.org_id
+  graph_name = apollo_graph.test.graph_name
+
+  depends_on = [apollo_graph.test]
+}
+`, os.Getenv("APOLLO_ORG_ID"))
+}
+
+const testAccGraphDataSourceConfigInvalid = `
+data "apollo_graph" "test" {
+  graph_id   = "one"
+  graph_name = "two"
+  org_id     = "org"
+}
+`
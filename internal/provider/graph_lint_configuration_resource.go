@@ -0,0 +1,300 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GraphLintConfigurationResource{}
+var _ resource.ResourceWithImportState = &GraphLintConfigurationResource{}
+
+func NewGraphLintConfigurationResource() resource.Resource {
+	return &GraphLintConfigurationResource{}
+}
+
+// GraphLintConfigurationResource defines the resource implementation.
+type GraphLintConfigurationResource struct {
+	client *client.Client
+}
+
+// GraphLintConfigurationResourceModel describes the resource data model.
+type GraphLintConfigurationResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	GraphId         types.String `tfsdk:"graph_id"`
+	Rules           types.Map    `tfsdk:"rules"`
+	IgnoredTags     types.Set    `tfsdk:"ignored_tags"`
+	AllowedTagNames types.Set    `tfsdk:"allowed_tag_names"`
+}
+
+func (r *GraphLintConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_graph_lint_configuration"
+}
+
+func (r *GraphLintConfigurationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	emptySet := setdefault.StaticValue(types.SetValueMust(types.StringType, nil))
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Schema linter configuration of a graph. Destroying the resource restores the default configuration.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the graph",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"graph_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the graph. Changing this forces a new resource to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rules": schema.MapAttribute{
+				MarkdownDescription: "Level of linter rules, keyed by rule name such as `FIELD_NAMES_SHOULD_BE_CAMEL_CASE`. Levels are `error`, `warn` or `ignore`. Rules not listed keep their current level.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.KeysAre(stringvalidator.OneOf(client.LintRules...)),
+					mapvalidator.ValueStringsAre(stringvalidator.OneOf(client.LintLevels...)),
+				},
+			},
+			"ignored_tags": schema.SetAttribute{
+				MarkdownDescription: "Names of the `@tag` markers whose elements the linter ignores",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             emptySet,
+			},
+			"allowed_tag_names": schema.SetAttribute{
+				MarkdownDescription: "`@tag` names accepted by the `TAG_DIRECTIVE_USES_UNKNOWN_NAME` rule",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             emptySet,
+			},
+		},
+	}
+}
+
+func (r *GraphLintConfigurationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GraphLintConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GraphLintConfigurationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configuration, diags := data.configuration(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateLintConfiguration(ctx, data.GraphId.ValueString(), configuration)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to configure schema linter, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, updated)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "configured schema linter", map[string]interface{}{"graph_id": data.GraphId.ValueString()})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GraphLintConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GraphLintConfigurationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configuration, err := r.client.GetLintConfiguration(ctx, data.GraphId.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "graph no longer exists, removing lint configuration from state", map[string]interface{}{"graph_id": data.GraphId.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schema linter configuration, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, configuration)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GraphLintConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GraphLintConfigurationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configuration, diags := data.configuration(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateLintConfiguration(ctx, data.GraphId.ValueString(), configuration)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update schema linter configuration, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, updated)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GraphLintConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GraphLintConfigurationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.ResetLintConfiguration(ctx, data.GraphId.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset schema linter configuration, got error: %s", err))
+		return
+	}
+}
+
+func (r *GraphLintConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("graph_id"), req.ID)...)
+}
+
+// configuration converts the model into the linter configuration sent to
+// Apollo.
+func (m *GraphLintConfigurationResourceModel) configuration(ctx context.Context) (client.LintConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var levels map[string]string
+	if !m.Rules.IsNull() {
+		diags.Append(m.Rules.ElementsAs(ctx, &levels, false)...)
+	}
+
+	// Sort the rules so that requests are stable across runs.
+	rules := make([]string, 0, len(levels))
+	for rule := range levels {
+		rules = append(rules, rule)
+	}
+	sort.Strings(rules)
+
+	configuration := client.LintConfiguration{Rules: make([]client.LintRuleLevel, 0, len(rules))}
+	for _, rule := range rules {
+		configuration.Rules = append(configuration.Rules, client.LintRuleLevel{Rule: rule, Level: levels[rule]})
+	}
+	diags.Append(m.IgnoredTags.ElementsAs(ctx, &configuration.IgnoredTags, false)...)
+	diags.Append(m.AllowedTagNames.ElementsAs(ctx, &configuration.AllowedTagNames, false)...)
+
+	configuration.IgnoredTags = nonNilStrings(configuration.IgnoredTags)
+	configuration.AllowedTagNames = nonNilStrings(configuration.AllowedTagNames)
+
+	return configuration, diags
+}
+
+// refresh copies the API representation of the configuration into the model.
+// The resource manages a subset of the rules, so only the rules already in the
+// model are refreshed and a null rules map stays null.
+func (m *GraphLintConfigurationResourceModel) refresh(ctx context.Context, configuration *client.LintConfiguration) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	m.Id = types.StringValue(m.GraphId.ValueString())
+
+	if !m.Rules.IsNull() && !m.Rules.IsUnknown() {
+		levels := configuration.RuleLevels()
+		managed := make(map[string]string, len(m.Rules.Elements()))
+		for rule := range m.Rules.Elements() {
+			if level, ok := levels[rule]; ok {
+				managed[rule] = level
+			}
+		}
+		if len(managed) > 0 {
+			m.Rules, d = types.MapValueFrom(ctx, types.StringType, managed)
+			diags.Append(d...)
+		} else {
+			m.Rules = types.MapNull(types.StringType)
+		}
+	}
+
+	m.IgnoredTags, d = types.SetValueFrom(ctx, types.StringType, nonNilStrings(configuration.IgnoredTags))
+	diags.Append(d...)
+	m.AllowedTagNames, d = types.SetValueFrom(ctx, types.StringType, nonNilStrings(configuration.AllowedTagNames))
+	diags.Append(d...)
+
+	return diags
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGraphLintConfigurationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGraphLintConfigurationResourceConfig("error"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_graph_lint_configuration.test", "rules.%", "2"),
+					resource.TestCheckResourceAttr("apollo_graph_lint_configuration.test", "rules.FIELD_NAMES_SHOULD_BE_CAMEL_CASE", "error"),
+					resource.TestCheckTypeSetElemAttr("apollo_graph_lint_configuration.test", "ignored_tags.*", "experimental"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "apollo_graph_lint_configuration.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Rules are not imported.
+				ImportStateVerifyIgnore: []string{"rules"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccGraphLintConfigurationResourceConfig("warn"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_graph_lint_configuration.test", "rules.FIELD_NAMES_SHOULD_BE_CAMEL_CASE", "warn"),
+				),
+			},
+			// Tags only, with no managed rules
+			{
+				Config: testAccGraphLintConfigurationResourceTagsConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("apollo_graph_lint_configuration.test", "rules.%"),
+					resource.TestCheckTypeSetElemAttr("apollo_graph_lint_configuration.test", "allowed_tag_names.*", "public"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccGraphLintConfigurationResourceConfig(level string) string {
+	return fmt.Sprintf(`
+resource "apollo_graph" "test" {
+  org_id     = %[1]q
+  graph_name = "tf-acc-lint-graph"
+}
+
+resource "apollo_graph_lint_configuration" "test" {
+  graph_id = apollo_graph.test.graph_id
+
+  rules = {
+    FIELD_NAMES_SHOULD_BE_CAMEL_CASE = %[2]q
+    RESTY_FIELD_NAMES                = "ignore"
+  }
+
+  ignored_tags = ["experimental"]
+}
+`, os.Getenv("APOLLO_ORG_ID"), level)
+}
+
+func testAccGraphLintConfigurationResourceTagsConfig() string {
+	return fmt.Sprintf(`
+resource "apollo_graph" "test" {
+  org_id     = %[1]q
+  graph_name = "tf-acc-lint-graph"
+}
+
+resource "apollo_graph_lint_configuration" "test" {
+  graph_id          = apollo_graph.test.graph_id
+  allowed_tag_names = ["public", "internal"]
+}
+`, os.Getenv("APOLLO_ORG_ID"))
+}
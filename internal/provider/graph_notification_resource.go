@@ -0,0 +1,265 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GraphNotificationResource{}
+var _ resource.ResourceWithImportState = &GraphNotificationResource{}
+
+func NewGraphNotificationResource() resource.Resource {
+	return &GraphNotificationResource{}
+}
+
+// GraphNotificationResource defines the resource implementation.
+type GraphNotificationResource struct {
+	client *client.Client
+}
+
+// GraphNotificationResourceModel describes the resource data model.
+type GraphNotificationResourceModel struct {
+	Id        types.String `tfsdk:"id"`
+	GraphId   types.String `tfsdk:"graph_id"`
+	Variant   types.String `tfsdk:"variant"`
+	ChannelId types.String `tfsdk:"channel_id"`
+	Events    types.Set    `tfsdk:"events"`
+}
+
+func (r *GraphNotificationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_graph_notification"
+}
+
+func (r *GraphNotificationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Subscribes an `apollo_notification_channel` to events of a graph variant",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the subscription",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"graph_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the graph. Changing this forces a new subscription to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"variant": schema.StringAttribute{
+				MarkdownDescription: "Variant whose events are sent. Defaults to `current`. Changing this forces a new subscription to be created.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("current"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the notification channel events are sent to. Changing this forces a new subscription to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"events": schema.SetAttribute{
+				MarkdownDescription: "Events sent to the channel, any of `" + strings.Join(client.NotificationEvents, "`, `") + "`",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(client.NotificationEvents...)),
+				},
+			},
+		},
+	}
+}
+
+func (r *GraphNotificationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GraphNotificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GraphNotificationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var events []string
+	resp.Diagnostics.Append(data.Events.ElementsAs(ctx, &events, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subscription, err := r.client.CreateNotificationSubscription(ctx, data.GraphId.ValueString(), data.Variant.ValueString(), data.ChannelId.ValueString(), events)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create notification subscription, got error: %s", err))
+		return
+	}
+
+	// The subscription exists from here on, so it is saved even if refreshing
+	// the model fails, keeping the planned events.
+	resp.Diagnostics.Append(data.refresh(ctx, subscription)...)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a notification subscription", map[string]interface{}{"graph_id": data.GraphId.ValueString(), "id": subscription.ID})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GraphNotificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GraphNotificationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subscription, err := r.client.GetNotificationSubscription(ctx, data.GraphId.ValueString(), data.Id.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "notification subscription no longer exists, removing from state", map[string]interface{}{"graph_id": data.GraphId.ValueString(), "id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read notification subscription, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, subscription)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GraphNotificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state GraphNotificationResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var events []string
+	resp.Diagnostics.Append(data.Events.ElementsAs(ctx, &events, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subscription, err := r.client.UpdateNotificationSubscription(ctx, state.GraphId.ValueString(), state.Id.ValueString(), events)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update notification subscription, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, subscription)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GraphNotificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GraphNotificationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteNotificationSubscription(ctx, data.GraphId.ValueString(), data.Id.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete notification subscription, got error: %s", err))
+		return
+	}
+}
+
+func (r *GraphNotificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	graphId, id, ok := strings.Cut(req.ID, "/")
+	if !ok || graphId == "" || id == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <graph_id>/<subscription_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("graph_id"), graphId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// refresh copies the API representation of the subscription into the model.
+// The identifying attributes are always copied, so that the model can be saved
+// even when the events cannot be converted.
+func (m *GraphNotificationResourceModel) refresh(ctx context.Context, subscription *client.NotificationSubscription) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Id = types.StringValue(subscription.ID)
+	m.Variant = types.StringValue(subscription.Variant)
+	m.ChannelId = types.StringValue(subscription.Channel.ID)
+
+	events, d := types.SetValueFrom(ctx, types.StringType, nonNilStrings(subscription.Events))
+	diags.Append(d...)
+	if !d.HasError() {
+		m.Events = events
+	}
+
+	return diags
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccGraphNotificationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGraphNotificationResourceConfig(`["SCHEMA_CHANGE"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_graph_notification.test", "variant", "current"),
+					resource.TestCheckResourceAttr("apollo_graph_notification.test", "events.#", "1"),
+					resource.TestCheckResourceAttrPair("apollo_graph_notification.test", "channel_id", "apollo_notification_channel.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "apollo_graph_notification.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					attributes := s.RootModule().Resources["apollo_graph_notification.test"].Primary.Attributes
+					return attributes["graph_id"] + "/" + attributes["id"], nil
+				},
+			},
+			// Update and Read testing
+			{
+				Config: testAccGraphNotificationResourceConfig(`["SCHEMA_CHANGE", "BUILD_ERROR", "DAILY_REPORT"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_graph_notification.test", "events.#", "3"),
+					resource.TestCheckTypeSetElemAttr("apollo_graph_notification.test", "events.*", "BUILD_ERROR"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccGraphNotificationResourceConfig(events string) string {
+	return fmt.Sprintf(`
+resource "apollo_graph" "test" {
+  org_id     = %[1]q
+  graph_name = "tf-acc-notification-graph"
+}
+
+resource "apollo_notification_channel" "test" {
+  org_id = %[1]q
+  name   = "tf-acc-notifications"
+  type   = "WEBHOOK"
+  url    = "https://example.com/apollo-hook"
+}
+
+resource "apollo_graph_notification" "test" {
+  graph_id   = apollo_graph.test.graph_id
+  channel_id = apollo_notification_channel.test.id
+  events     = %[2]s
+}
+`, os.Getenv("APOLLO_ORG_ID"), events)
+}
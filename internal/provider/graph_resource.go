@@ -6,28 +6,28 @@ package provider
 import (
 	"context"
 	"fmt"
-	"io/ioutil"
-	"net/http"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/client"
 	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/helpers"
-	"github.com/machinebox/graphql"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &GraphResource{}
 var _ resource.ResourceWithImportState = &GraphResource{}
+var _ resource.ResourceWithModifyPlan = &GraphResource{}
 
-type Response struct {
-	Data Data `json:"data"`
-}
+// graphIdAttempts is how many generated IDs Create tries before giving up.
+const graphIdAttempts = 5
 
 func NewGraphResource() resource.Resource {
 	return &GraphResource{}
@@ -43,6 +43,7 @@ type GraphResourceModel struct {
 	OrgId     types.String `tfsdk:"org_id"`
 	GraphName types.String `tfsdk:"graph_name"`
 	GraphId   types.String `tfsdk:"graph_id"`
+	Hidden    types.Bool   `tfsdk:"hidden_from_uninvited_non_admins"`
 }
 
 func (r *GraphResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -56,16 +57,36 @@ func (r *GraphResource) Schema(ctx context.Context, req resource.SchemaRequest,
 
 		Attributes: map[string]schema.Attribute{
 			"org_id": schema.StringAttribute{
-				MarkdownDescription: "Organization ID for Apollo Studio",
+				MarkdownDescription: "Organization ID for Apollo Studio. Changing this forces a new graph to be created.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"graph_name": schema.StringAttribute{
-				MarkdownDescription: "Name of your graph",
+				MarkdownDescription: "Name of your graph, shown as its title in Apollo Studio",
 				Required:            true,
 			},
 			"graph_id": schema.StringAttribute{
-				MarkdownDescription: "ID of your graph",
-				Computed:            true,
+				MarkdownDescription: "ID of your graph. Must start with a letter and contain only letters, numbers, underscores and hyphens. " +
+					"When omitted, an ID is generated from `graph_name` and kept stable for the life of the graph. Changing this forces a new graph to be created.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(helpers.GraphIdMaxLength),
+					stringvalidator.RegexMatches(helpers.GraphIdPattern, "must start with a letter and contain only letters, numbers, underscores and hyphens"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hidden_from_uninvited_non_admins": schema.BoolAttribute{
+				MarkdownDescription: "Whether the graph is hidden from organization members who are neither admins nor invited to it. " +
+					"Grant access to hidden graphs with `apollo_graph_access`. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
 			},
 		},
 	}
@@ -90,55 +111,67 @@ func (r *GraphResource) Configure(ctx context.Context, req resource.ConfigureReq
 	r.client = client
 }
 
-func (r *GraphResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data GraphResourceModel
-
-	apollo := client.Client{
-		ApiKey: r.client.ApiKey,
+func (r *GraphResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Only graphs about to be created need their organization checked.
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() || r.client == nil {
+		return
 	}
 
-	// Read Terraform plan data into the model
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
-	if resp.Diagnostics.HasError() {
+	var orgId types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("org_id"), &orgId)...)
+	if resp.Diagnostics.HasError() || orgId.IsUnknown() {
 		return
 	}
-	graphId := data.GraphName.ValueString() + helpers.RandomNumberString(5)
-	data.GraphId = basetypes.NewStringValue(graphId)
-
-	url := "https://graphql.api.apollographql.com/api/graphql"
-	method := "POST"
-	orgId := data.OrgId.ValueString()
-	graphName := data.GraphName.ValueString()
 
-	payload := strings.NewReader("{\"query\":\"mutation Service($orgId: ID!, $id: ID!, $name: String!, $adminOnly: Boolean!) {\\n    newService(accountId: $orgId, id: $id, name: $name, hiddenFromUninvitedNonAdminAccountMembers: $adminOnly) {\\n      id\\n      name\\n      title\\n    }\\n  }\",\"variables\":{\"orgId\":\"" + orgId + "\",\"id\":\"" + graphId + "\",\"name\":\"" + graphName + "\",\"adminOnly\":false}}")
-
-	client := &http.Client{}
-	request, err := http.NewRequest(method, url, payload)
-
-	if err != nil {
-		resp.Diagnostics.AddError("http request error", fmt.Sprintf("Unable to wrap http request, got error: %s", err))
+	_, err := r.client.GetOrganization(ctx, orgId.ValueString())
+	if client.IsNotFound(err) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("org_id"),
+			"Unknown Organization",
+			fmt.Sprintf("The organization %q does not exist or the configured API key is not a member of it.", orgId.ValueString()),
+		)
 		return
 	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(path.Root("org_id"), "Client Error", fmt.Sprintf("Unable to read organization, got error: %s", err))
+	}
+}
 
-	request.Header.Add("Content-Type", "application/json")
-	request.Header.Add("x-api-key", apollo.ApiKey)
+func (r *GraphResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GraphResourceModel
 
-	response, err := client.Do(request)
-	if err != nil {
-		resp.Diagnostics.AddError("http request error", fmt.Sprintf("Unable to do http request, got error: %s", err))
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	defer response.Body.Close()
 
-	body, err := ioutil.ReadAll(response.Body)
+	var graph *client.Graph
+	var err error
+	if !data.GraphId.IsUnknown() && !data.GraphId.IsNull() {
+		graph, err = r.client.CreateGraph(ctx, data.OrgId.ValueString(), data.GraphId.ValueString(), data.GraphName.ValueString(), data.Hidden.ValueBool())
+	} else {
+		// Generated IDs carry a random suffix, so retry a few times if one
+		// happens to be taken already.
+		for attempt := 1; attempt <= graphIdAttempts; attempt++ {
+			graphId := helpers.GraphIdFromName(data.GraphName.ValueString())
+			graph, err = r.client.CreateGraph(ctx, data.OrgId.ValueString(), graphId, data.GraphName.ValueString(), data.Hidden.ValueBool())
+			if !client.IsConflict(err) {
+				break
+			}
+			tflog.Debug(ctx, "generated graph ID already exists, retrying", map[string]interface{}{"graph_id": graphId, "attempt": attempt})
+		}
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("body read error", fmt.Sprintf("Unable to read response body, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create graph, got error: %s", err))
 		return
 	}
-	ctx = tflog.SetField(ctx, "lookie", string(body))
+	data.GraphId = types.StringValue(graph.ID)
+	data.Hidden = types.BoolValue(graph.HiddenFromUninvitedNonAdminAccountMembers)
+
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
-	tflog.Trace(ctx, "created a resource")
+	tflog.Trace(ctx, "created a graph", map[string]interface{}{"graph_id": graph.ID})
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -154,35 +187,61 @@ func (r *GraphResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	// If applicable, this is a great opportunity to initialize any necessary
-	// provider client data and make a call using it.
-	// httpResp, err := r.client.Do(httpReq)
-	// if err != nil {
-	//     resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Graph, got error: %s", err))
-	//     return
-	// }
+	graph, err := r.client.GetGraph(ctx, data.GraphId.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "graph no longer exists, removing from state", map[string]interface{}{"graph_id": data.GraphId.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read graph, got error: %s", err))
+		return
+	}
+
+	data.GraphId = types.StringValue(graph.ID)
+	data.GraphName = types.StringValue(graph.DisplayName())
+	data.Hidden = types.BoolValue(graph.HiddenFromUninvitedNonAdminAccountMembers)
+	if graph.Account != nil {
+		data.OrgId = types.StringValue(graph.Account.ID)
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *GraphResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data GraphResourceModel
+	var data, state GraphResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and prior state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// If applicable, this is a great opportunity to initialize any necessary
-	// provider client data and make a call using it.
-	// httpResp, err := r.client.Do(httpReq)
-	// if err != nil {
-	//     resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update Graph, got error: %s", err))
-	//     return
-	// }
+	if !data.GraphName.Equal(state.GraphName) {
+		graph, err := r.client.UpdateGraphTitle(ctx, state.GraphId.ValueString(), data.GraphName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update graph, got error: %s", err))
+			return
+		}
+		data.GraphName = types.StringValue(graph.DisplayName())
+		state.GraphName = data.GraphName
+	}
+
+	if !data.Hidden.Equal(state.Hidden) {
+		graph, err := r.client.UpdateGraphHidden(ctx, state.GraphId.ValueString(), data.Hidden.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update graph visibility, got error: %s", err))
+			// Keep the title change that was already applied.
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			return
+		}
+		data.Hidden = types.BoolValue(graph.HiddenFromUninvitedNonAdminAccountMembers)
+	}
+
+	data.GraphId = state.GraphId
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -198,35 +257,13 @@ func (r *GraphResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	//Initialize Apollo client
-	apollo := client.Client{
-		ApiKey:            r.client.ApiKey,
-		EnterPriseEnabled: false,
-		GraphClient:       graphql.NewClient("https://graphql.api.apollographql.com/api/graphql"),
-	}
-	apollo.Init()
-
-	var response Response
-	graphId := data.GraphId.ValueString()
-
-	err := apollo.Query(ctx, `
-
-		mutation Service
-		{
-			service( id:  "`+graphId+`") {
-		 		delete
-			}
-	  }
-		`,
-		&response)
-
-	if err != nil {
-		resp.Diagnostics.AddError("delete graph error", fmt.Sprintf("Unable to delete graph, got error: %s", err))
+	err := r.client.DeleteGraph(ctx, data.GraphId.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete graph, got error: %s", err))
+		return
 	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *GraphResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("graph_id"), req, resp)
 }
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/helpers"
+)
+
+func TestAccGraphResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGraphResourceConfig("tf-acc-graph"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_graph.test", "graph_name", "tf-acc-graph"),
+					resource.TestCheckResourceAttr("apollo_graph.test", "org_id", os.Getenv("APOLLO_ORG_ID")),
+					resource.TestCheckResourceAttrSet("apollo_graph.test", "graph_id"),
+				),
+			},
+			// Refresh testing
+			{
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_graph.test", "graph_name", "tf-acc-graph"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "apollo_graph.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "graph_id",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["apollo_graph.test"].Primary.Attributes["graph_id"], nil
+				},
+			},
+			// Update and Read testing
+			{
+				Config: testAccGraphResourceConfig("tf-acc-graph-renamed"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("apollo_graph.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_graph.test", "graph_name", "tf-acc-graph-renamed"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccGraphResource_graphId(t *testing.T) {
+	graphId := "tf-acc-" + helpers.RandomNumberString(8)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGraphResourceConfigWithId("1-invalid", "tf-acc-graph"),
+				ExpectError: regexp.MustCompile(`must start with a letter`),
+			},
+			{
+				Config: testAccGraphResourceConfigWithId(graphId, "tf-acc-graph"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_graph.test", "graph_id", graphId),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGraphResource_unknownOrg(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "apollo_graph" "test" {
+  org_id     = %q
+  graph_name = "tf-acc-graph"
+}
+`, "tf-acc-missing-org-"+helpers.RandomNumberString(8)),
+				ExpectError: regexp.MustCompile(`Unknown Organization`),
+			},
+		},
+	})
+}
+
+func TestAccGraphResource_hidden(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGraphResourceConfigHidden(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_graph.test", "hidden_from_uninvited_non_admins", "true"),
+				),
+			},
+			{
+				Config: testAccGraphResourceConfigHidden(false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("apollo_graph.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_graph.test", "hidden_from_uninvited_non_admins", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGraphResourceConfig(graphName string) string {
+	return fmt.Sprintf(`
+resource "apollo_graph" "test" {
+  org_id     = %[1]q
+  graph_name = %[2]q
+}
+`, os.Getenv("APOLLO_ORG_ID"), graphName)
+}
+
+func testAccGraphResourceConfigWithId(graphId, graphName string) string {
+	return fmt.Sprintf(`
+resource "apollo_graph" "test" {
+  org_id     = %[1]q
+  graph_id   = %[2]q
+  graph_name = %[3]q
+}
+`, os.Getenv("APOLLO_ORG_ID"), graphId, graphName)
+}
+
+func testAccGraphResourceConfigHidden(hidden bool) string {
+	return fmt.Sprintf(`
+resource "apollo_graph" "test" {
+  org_id                           = %[1]q
+  graph_name                       = "tf-acc-hidden-graph"
+  hidden_from_uninvited_non_admins = %[2]t
+}
+`, os.Getenv("APOLLO_ORG_ID"), hidden)
+}
@@ -0,0 +1,299 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GraphVariantResource{}
+var _ resource.ResourceWithImportState = &GraphVariantResource{}
+
+func NewGraphVariantResource() resource.Resource {
+	return &GraphVariantResource{}
+}
+
+// GraphVariantResource defines the resource implementation.
+type GraphVariantResource struct {
+	client *client.Client
+}
+
+// GraphVariantResourceModel describes the resource data model.
+type GraphVariantResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	GraphId         types.String `tfsdk:"graph_id"`
+	Name            types.String `tfsdk:"name"`
+	IsPublic        types.Bool   `tfsdk:"is_public"`
+	PreflightScript types.String `tfsdk:"preflight_script"`
+	DefaultHeaders  types.Map    `tfsdk:"default_headers"`
+}
+
+func (r *GraphVariantResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_graph_variant"
+}
+
+func (r *GraphVariantResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Graph variant resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Graph ref of the variant, in the form `<graph_id>@<name>`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"graph_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the graph the variant belongs to. Changing this forces a new variant to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the variant, such as `current` or `staging`. Changing this forces a new variant to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"is_public": schema.BoolAttribute{
+				MarkdownDescription: "Whether the variant is publicly visible in Explorer. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"preflight_script": schema.StringAttribute{
+				MarkdownDescription: "Preflight script run by Explorer before each operation",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"default_headers": schema.MapAttribute{
+				MarkdownDescription: "Headers Explorer sends with every operation against the variant",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *GraphVariantResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GraphVariantResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GraphVariantResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, diags := data.settings(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateVariant(ctx, data.GraphId.ValueString(), data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create graph variant, got error: %s", err))
+		return
+	}
+
+	// Track the variant before applying its settings, so that it is tainted
+	// rather than orphaned if they cannot be applied.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), client.VariantRef(data.GraphId.ValueString(), created.Name))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("graph_id"), data.GraphId.ValueString())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), created.Name)...)
+
+	variant, err := r.client.UpdateVariant(ctx, data.GraphId.ValueString(), data.Name.ValueString(), settings)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to configure graph variant, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, data.GraphId.ValueString(), variant)...)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a graph variant", map[string]interface{}{"id": data.Id.ValueString()})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GraphVariantResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GraphVariantResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	variant, err := r.client.GetVariant(ctx, data.GraphId.ValueString(), data.Name.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "graph variant no longer exists, removing from state", map[string]interface{}{"id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read graph variant, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, data.GraphId.ValueString(), variant)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GraphVariantResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GraphVariantResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, diags := data.settings(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	variant, err := r.client.UpdateVariant(ctx, data.GraphId.ValueString(), data.Name.ValueString(), settings)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update graph variant, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, data.GraphId.ValueString(), variant)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GraphVariantResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GraphVariantResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteVariant(ctx, data.GraphId.ValueString(), data.Name.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete graph variant, got error: %s", err))
+		return
+	}
+}
+
+func (r *GraphVariantResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	graphId, name, err := client.ParseVariantRef(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("graph_id"), graphId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+// settings converts the configurable attributes of the model into the
+// settings sent to Apollo.
+func (m *GraphVariantResourceModel) settings(ctx context.Context) (client.VariantSettings, diag.Diagnostics) {
+	settings := client.VariantSettings{
+		IsPublic: m.IsPublic.ValueBool(),
+	}
+
+	if !m.PreflightScript.IsNull() {
+		script := m.PreflightScript.ValueString()
+		settings.PreflightScript = &script
+	}
+
+	var diags diag.Diagnostics
+	if !m.DefaultHeaders.IsNull() {
+		diags = m.DefaultHeaders.ElementsAs(ctx, &settings.DefaultHeaders, false)
+	}
+
+	return settings, diags
+}
+
+// refresh copies the state of variant into the model.
+func (m *GraphVariantResourceModel) refresh(ctx context.Context, graphId string, variant *client.Variant) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Id = types.StringValue(client.VariantRef(graphId, variant.Name))
+	m.GraphId = types.StringValue(graphId)
+	m.Name = types.StringValue(variant.Name)
+	m.IsPublic = types.BoolValue(variant.IsPublic)
+
+	if variant.PreflightScript != nil && *variant.PreflightScript != "" {
+		m.PreflightScript = types.StringValue(*variant.PreflightScript)
+	} else {
+		m.PreflightScript = types.StringNull()
+	}
+
+	headers, err := variant.DefaultHeaders()
+	if err != nil {
+		diags.AddError("Client Error", err.Error())
+		return diags
+	}
+	if len(headers) > 0 {
+		var d diag.Diagnostics
+		m.DefaultHeaders, d = types.MapValueFrom(ctx, types.StringType, headers)
+		diags.Append(d...)
+	} else {
+		m.DefaultHeaders = types.MapNull(types.StringType)
+	}
+
+	return diags
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGraphVariantResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGraphVariantResourceConfig(false, "Bearer one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_graph_variant.test", "name", "staging"),
+					resource.TestCheckResourceAttr("apollo_graph_variant.test", "is_public", "false"),
+					resource.TestCheckResourceAttr("apollo_graph_variant.test", "default_headers.Authorization", "Bearer one"),
+					resource.TestCheckResourceAttrPair("apollo_graph_variant.test", "graph_id", "apollo_graph.test", "graph_id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "apollo_graph_variant.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccGraphVariantResourceConfig(true, "Bearer two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_graph_variant.test", "is_public", "true"),
+					resource.TestCheckResourceAttr("apollo_graph_variant.test", "default_headers.Authorization", "Bearer two"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccGraphVariantResourceConfig(isPublic bool, authorization string) string {
+	return fmt.Sprintf(`
+resource "apollo_graph" "test" {
+  org_id     = %[1]q
+  graph_name = "tf-acc-variant-graph"
+}
+
+resource "apollo_graph_variant" "test" {
+  graph_id  = apollo_graph.test.graph_id
+  name      = "staging"
+  is_public = %[2]t
+
+  default_headers = {
+    Authorization = %[3]q
+  }
+}
+`, os.Getenv("APOLLO_ORG_ID"), isPublic, authorization)
+}
@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GraphsDataSource{}
+
+func NewGraphsDataSource() datasource.DataSource {
+	return &GraphsDataSource{}
+}
+
+// GraphsDataSource defines the data source implementation.
+type GraphsDataSource struct {
+	client *client.Client
+}
+
+// GraphsDataSourceModel describes the data source data model.
+type GraphsDataSourceModel struct {
+	OrgId  types.String          `tfsdk:"org_id"`
+	Graphs []GraphsDataItemModel `tfsdk:"graphs"`
+}
+
+// GraphsDataItemModel describes a single graph of the organization.
+type GraphsDataItemModel struct {
+	GraphId   types.String   `tfsdk:"graph_id"`
+	Title     types.String   `tfsdk:"title"`
+	Variants  []types.String `tfsdk:"variants"`
+	CreatedAt types.String   `tfsdk:"created_at"`
+}
+
+func (d *GraphsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_graphs"
+}
+
+func (d *GraphsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists every graph of an organization",
+
+		Attributes: map[string]schema.Attribute{
+			"org_id": schema.StringAttribute{
+				MarkdownDescription: "Organization ID for Apollo Studio",
+				Required:            true,
+			},
+			"graphs": schema.ListNestedAttribute{
+				MarkdownDescription: "Graphs of the organization",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"graph_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the graph",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Title of the graph",
+							Computed:            true,
+						},
+						"variants": schema.ListAttribute{
+							MarkdownDescription: "Names of the variants of the graph",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Time the graph was created, in RFC 3339 format",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *GraphsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *GraphsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GraphsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	graphs, err := d.client.ListGraphs(ctx, data.OrgId.ValueString())
+	if client.IsNotFound(err) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("org_id"),
+			"Organization Not Found",
+			fmt.Sprintf("Organization %q does not exist or the configured API key is not a member of it.", data.OrgId.ValueString()),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list graphs, got error: %s", err))
+		return
+	}
+
+	data.Graphs = make([]GraphsDataItemModel, 0, len(graphs))
+	for _, graph := range graphs {
+		variants := make([]types.String, 0, len(graph.Variants))
+		for _, variant := range graph.Variants {
+			variants = append(variants, types.StringValue(variant.Name))
+		}
+
+		data.Graphs = append(data.Graphs, GraphsDataItemModel{
+			GraphId:   types.StringValue(graph.ID),
+			Title:     types.StringValue(graph.Title),
+			Variants:  variants,
+			CreatedAt: types.StringValue(graph.CreatedAt),
+		})
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "listed graphs", map[string]interface{}{"org_id": data.OrgId.ValueString(), "count": len(graphs)})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGraphsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGraphsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.apollo_graphs.test", "graphs.*", map[string]string{
+						"title": "tf-acc-graphs-graph",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccGraphsDataSourceConfig() string {
+	return fmt.Sprintf(`
+resource "apollo_graph" "test" {
+  org_id     = %[1]q
+  graph_name = "tf-acc-graphs-graph"
+}
+
+data "apollo_graphs" "test" {
+  org_id = apollo_graph.test.org_id
+
+  depends_on = [apollo_graph.test]
+}
+`, os.Getenv("APOLLO_ORG_ID"))
+}
@@ -0,0 +1,302 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NotificationChannelResource{}
+var _ resource.ResourceWithValidateConfig = &NotificationChannelResource{}
+var _ resource.ResourceWithImportState = &NotificationChannelResource{}
+
+func NewNotificationChannelResource() resource.Resource {
+	return &NotificationChannelResource{}
+}
+
+// NotificationChannelResource defines the resource implementation.
+type NotificationChannelResource struct {
+	client *client.Client
+}
+
+// NotificationChannelResourceModel describes the resource data model.
+type NotificationChannelResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	OrgId       types.String `tfsdk:"org_id"`
+	Name        types.String `tfsdk:"name"`
+	Type        types.String `tfsdk:"type"`
+	Url         types.String `tfsdk:"url"`
+	RoutingKey  types.String `tfsdk:"routing_key"`
+	SecretToken types.String `tfsdk:"secret_token"`
+}
+
+func (r *NotificationChannelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_channel"
+}
+
+func (r *NotificationChannelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Notification channel of an organization. Subscribe a channel to graph events with `apollo_graph_notification`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the channel",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				MarkdownDescription: "Organization ID for Apollo Studio. Changing this forces a new channel to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the channel, shown in Apollo Studio",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Kind of channel, one of `" + strings.Join(client.ChannelTypes, "`, `") + "`. Changing this forces a new channel to be created.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.ChannelTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "Slack incoming-webhook URL or webhook endpoint. Required for `SLACK` and `WEBHOOK` channels.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"routing_key": schema.StringAttribute{
+				MarkdownDescription: "PagerDuty Events API v2 routing key. Required for `PAGERDUTY` channels. Apollo never returns it, so changes made outside Terraform are not detected.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"secret_token": schema.StringAttribute{
+				MarkdownDescription: "Token used to sign `WEBHOOK` payloads. Apollo never returns it, so changes made outside Terraform are not detected.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *NotificationChannelResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data NotificationChannelResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Type.IsUnknown() || data.Type.IsNull() {
+		return
+	}
+
+	channelType := data.Type.ValueString()
+	required := func(attribute string, value types.String) {
+		if value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				"Missing Attribute Configuration",
+				fmt.Sprintf("%s is required for %s channels.", attribute, channelType),
+			)
+		}
+	}
+	unused := func(attribute string, value types.String) {
+		if !value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				"Invalid Attribute Configuration",
+				fmt.Sprintf("%s cannot be set for %s channels.", attribute, channelType),
+			)
+		}
+	}
+
+	switch channelType {
+	case client.ChannelTypeSlack:
+		required("url", data.Url)
+		unused("routing_key", data.RoutingKey)
+		unused("secret_token", data.SecretToken)
+	case client.ChannelTypeWebhook:
+		required("url", data.Url)
+		unused("routing_key", data.RoutingKey)
+	case client.ChannelTypePagerDuty:
+		required("routing_key", data.RoutingKey)
+		unused("url", data.Url)
+		unused("secret_token", data.SecretToken)
+	}
+}
+
+func (r *NotificationChannelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NotificationChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NotificationChannelResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channel, err := r.client.CreateNotificationChannel(ctx, data.OrgId.ValueString(), data.channel())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create notification channel, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(channel.ID)
+	data.refresh(channel)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a notification channel", map[string]interface{}{"org_id": data.OrgId.ValueString(), "id": channel.ID})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NotificationChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NotificationChannelResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channel, err := r.client.GetNotificationChannel(ctx, data.OrgId.ValueString(), data.Id.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "notification channel no longer exists, removing from state", map[string]interface{}{"org_id": data.OrgId.ValueString(), "id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read notification channel, got error: %s", err))
+		return
+	}
+
+	data.refresh(channel)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NotificationChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state NotificationChannelResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channel, err := r.client.UpdateNotificationChannel(ctx, state.OrgId.ValueString(), state.Id.ValueString(), data.channel())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update notification channel, got error: %s", err))
+		return
+	}
+
+	data.Id = state.Id
+	data.refresh(channel)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NotificationChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NotificationChannelResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteNotificationChannel(ctx, data.OrgId.ValueString(), data.Id.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete notification channel, got error: %s", err))
+		return
+	}
+}
+
+func (r *NotificationChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	orgId, id, ok := strings.Cut(req.ID, "/")
+	if !ok || orgId == "" || id == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <org_id>/<channel_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_id"), orgId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// channel converts the configurable attributes of the model into the channel
+// sent to Apollo.
+func (m *NotificationChannelResourceModel) channel() client.NotificationChannel {
+	return client.NotificationChannel{
+		Name:       m.Name.ValueString(),
+		Type:       m.Type.ValueString(),
+		URL:        m.Url.ValueString(),
+		RoutingKey: m.RoutingKey.ValueString(),
+		Secret:     m.SecretToken.ValueString(),
+	}
+}
+
+// refresh copies the API representation of the channel into the model. The
+// routing key and secret token are write-only and keep their prior values.
+func (m *NotificationChannelResourceModel) refresh(channel *client.NotificationChannel) {
+	m.Name = types.StringValue(channel.Name)
+	m.Type = types.StringValue(channel.Type)
+	// Apollo may redact webhook URLs, in which case the configured one is kept.
+	if channel.URL != "" {
+		m.Url = types.StringValue(channel.URL)
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccNotificationChannelResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccNotificationChannelResourceConfig("tf-acc-webhook"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_notification_channel.test", "name", "tf-acc-webhook"),
+					resource.TestCheckResourceAttr("apollo_notification_channel.test", "type", "WEBHOOK"),
+					resource.TestCheckResourceAttrSet("apollo_notification_channel.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "apollo_notification_channel.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					attributes := s.RootModule().Resources["apollo_notification_channel.test"].Primary.Attributes
+					return attributes["org_id"] + "/" + attributes["id"], nil
+				},
+				// Secrets are write-only.
+				ImportStateVerifyIgnore: []string{"secret_token"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccNotificationChannelResourceConfig("tf-acc-webhook-renamed"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_notification_channel.test", "name", "tf-acc-webhook-renamed"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccNotificationChannelResource_typeAttributes(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "apollo_notification_channel" "test" {
+  org_id = "my-org"
+  name   = "on-call"
+  type   = "PAGERDUTY"
+  url    = "https://example.com/hook"
+}
+`,
+				ExpectError: regexp.MustCompile(`routing_key is required for PAGERDUTY channels`),
+			},
+		},
+	})
+}
+
+func testAccNotificationChannelResourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "apollo_notification_channel" "test" {
+  org_id       = %[1]q
+  name         = %[2]q
+  type         = "WEBHOOK"
+  url          = "https://example.com/apollo-hook"
+  secret_token = "tf-acc-secret"
+}
+`, os.Getenv("APOLLO_ORG_ID"), name)
+}
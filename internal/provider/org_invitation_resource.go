@@ -0,0 +1,244 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OrgInvitationResource{}
+var _ resource.ResourceWithImportState = &OrgInvitationResource{}
+
+func NewOrgInvitationResource() resource.Resource {
+	return &OrgInvitationResource{}
+}
+
+// OrgInvitationResource defines the resource implementation.
+type OrgInvitationResource struct {
+	client *client.Client
+}
+
+// OrgInvitationResourceModel describes the resource data model.
+type OrgInvitationResourceModel struct {
+	Id        types.String `tfsdk:"id"`
+	OrgId     types.String `tfsdk:"org_id"`
+	Email     types.String `tfsdk:"email"`
+	Role      types.String `tfsdk:"role"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	Accepted  types.Bool   `tfsdk:"accepted"`
+}
+
+func (r *OrgInvitationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_org_invitation"
+}
+
+func (r *OrgInvitationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Invites a user to an organization by email address. " +
+			"Once the invitation is accepted, manage the member's role with `apollo_org_member`. " +
+			"Destroying this resource revokes the invitation but does not remove a member who already joined.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the invitation",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				MarkdownDescription: "Organization ID for Apollo Studio. Changing this forces a new invitation to be sent.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email address to invite. Changing this forces a new invitation to be sent.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Role the user gets when accepting the invitation, one of `" + strings.Join(client.OrgRoles, "`, `") + "`. " +
+					"Invitations cannot be edited, so changing this forces a new invitation to be sent.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.OrgRoles...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Time the invitation was sent, in RFC 3339 format",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"accepted": schema.BoolAttribute{
+				MarkdownDescription: "Whether the invitation has been accepted",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *OrgInvitationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OrgInvitationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OrgInvitationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	invitation, err := r.client.Invite(ctx, data.OrgId.ValueString(), data.Email.ValueString(), data.Role.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to invite %s, got error: %s", data.Email.ValueString(), err))
+		return
+	}
+
+	data.refresh(invitation)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created an organization invitation", map[string]interface{}{"org_id": data.OrgId.ValueString(), "id": invitation.ID})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrgInvitationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OrgInvitationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	invitation, err := r.client.GetInvitation(ctx, data.OrgId.ValueString(), data.Id.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "organization invitation no longer exists, removing from state", map[string]interface{}{"org_id": data.OrgId.ValueString(), "id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organization invitation, got error: %s", err))
+		return
+	}
+
+	data.refresh(invitation)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrgInvitationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every configurable attribute requires replacement, so there is nothing
+	// to send to Apollo; carry the computed values over from state.
+	var data, state OrgInvitationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = state.Id
+	data.CreatedAt = state.CreatedAt
+	data.Accepted = state.Accepted
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrgInvitationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data OrgInvitationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Accepted invitations have already been consumed.
+	if data.Accepted.ValueBool() {
+		return
+	}
+
+	err := r.client.RemoveInvitation(ctx, data.OrgId.ValueString(), data.Id.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to revoke organization invitation, got error: %s", err))
+		return
+	}
+}
+
+func (r *OrgInvitationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	orgId, id, ok := strings.Cut(req.ID, "/")
+	if !ok || orgId == "" || id == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <org_id>/<invitation_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_id"), orgId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// refresh copies the API representation of the invitation into the model.
+func (m *OrgInvitationResourceModel) refresh(invitation *client.Invitation) {
+	m.Id = types.StringValue(invitation.ID)
+	// Apollo may normalize the case of the address; keep the configured
+	// spelling unless the invitation is for someone else entirely.
+	if !strings.EqualFold(m.Email.ValueString(), invitation.Email) {
+		m.Email = types.StringValue(invitation.Email)
+	}
+	m.Role = types.StringValue(invitation.Role)
+	m.CreatedAt = types.StringValue(invitation.CreatedAt)
+	m.Accepted = types.BoolValue(invitation.AcceptedAt != nil)
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccOrgInvitationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccOrgInvitationResourceConfig("OBSERVER"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_org_invitation.test", "email", "tf-acc-invite@example.com"),
+					resource.TestCheckResourceAttr("apollo_org_invitation.test", "role", "OBSERVER"),
+					resource.TestCheckResourceAttr("apollo_org_invitation.test", "accepted", "false"),
+					resource.TestCheckResourceAttrSet("apollo_org_invitation.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "apollo_org_invitation.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					attributes := s.RootModule().Resources["apollo_org_invitation.test"].Primary.Attributes
+					return attributes["org_id"] + "/" + attributes["id"], nil
+				},
+			},
+			// Changing the role sends a new invitation
+			{
+				Config: testAccOrgInvitationResourceConfig("CONTRIBUTOR"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("apollo_org_invitation.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_org_invitation.test", "role", "CONTRIBUTOR"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccOrgInvitationResourceConfig(role string) string {
+	return fmt.Sprintf(`
+resource "apollo_org_invitation" "test" {
+  org_id = %[1]q
+  email  = "tf-acc-invite@example.com"
+  role   = %[2]q
+}
+`, os.Getenv("APOLLO_ORG_ID"), role)
+}
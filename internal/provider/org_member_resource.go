@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OrgMemberResource{}
+var _ resource.ResourceWithImportState = &OrgMemberResource{}
+
+func NewOrgMemberResource() resource.Resource {
+	return &OrgMemberResource{}
+}
+
+// OrgMemberResource defines the resource implementation.
+type OrgMemberResource struct {
+	client *client.Client
+}
+
+// OrgMemberResourceModel describes the resource data model.
+type OrgMemberResourceModel struct {
+	Id    types.String `tfsdk:"id"`
+	OrgId types.String `tfsdk:"org_id"`
+	Email types.String `tfsdk:"email"`
+	Name  types.String `tfsdk:"name"`
+	Role  types.String `tfsdk:"role"`
+}
+
+func (r *OrgMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_org_member"
+}
+
+func (r *OrgMemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages the role of an existing member of an organization. " +
+			"Users who have not joined the organization yet must be invited with `apollo_org_invitation` first. " +
+			"Destroying this resource removes the member from the organization.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "User ID of the member",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				MarkdownDescription: "Organization ID for Apollo Studio. Changing this forces a new resource to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email address of the member. Changing this forces a new resource to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the member",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Role of the member, one of `" + strings.Join(client.OrgRoles, "`, `") + "`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.OrgRoles...),
+				},
+			},
+		},
+	}
+}
+
+func (r *OrgMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OrgMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OrgMemberResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, err := r.client.GetMembershipByEmail(ctx, data.OrgId.ValueString(), data.Email.ValueString())
+	if client.IsNotFound(err) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("email"),
+			"Member Not Found",
+			fmt.Sprintf("%s is not a member of organization %q. Invite them with apollo_org_invitation and apply again once they have accepted.",
+				data.Email.ValueString(), data.OrgId.ValueString()),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organization members, got error: %s", err))
+		return
+	}
+
+	if member.Permission != data.Role.ValueString() {
+		member, err = r.client.UpdateMemberRole(ctx, data.OrgId.ValueString(), member.User.ID, data.Role.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update member role, got error: %s", err))
+			return
+		}
+	}
+
+	data.Id = types.StringValue(member.User.ID)
+	data.Name = types.StringValue(member.User.Name)
+	data.Role = types.StringValue(member.Permission)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "adopted an organization member", map[string]interface{}{"org_id": data.OrgId.ValueString(), "id": member.User.ID})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrgMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OrgMemberResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var member *client.Membership
+	var err error
+	if data.Id.IsNull() {
+		// Imported members are identified by email address.
+		member, err = r.client.GetMembershipByEmail(ctx, data.OrgId.ValueString(), data.Email.ValueString())
+	} else {
+		member, err = r.client.GetMembership(ctx, data.OrgId.ValueString(), data.Id.ValueString())
+	}
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "organization member no longer exists, removing from state", map[string]interface{}{"org_id": data.OrgId.ValueString(), "email": data.Email.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organization member, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(member.User.ID)
+	if !strings.EqualFold(data.Email.ValueString(), member.User.Email) {
+		data.Email = types.StringValue(member.User.Email)
+	}
+	data.Name = types.StringValue(member.User.Name)
+	data.Role = types.StringValue(member.Permission)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrgMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state OrgMemberResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, err := r.client.UpdateMemberRole(ctx, state.OrgId.ValueString(), state.Id.ValueString(), data.Role.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update member role, got error: %s", err))
+		return
+	}
+
+	data.Id = state.Id
+	data.Name = types.StringValue(member.User.Name)
+	data.Role = types.StringValue(member.Permission)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrgMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data OrgMemberResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RemoveMember(ctx, data.OrgId.ValueString(), data.Id.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove organization member, got error: %s", err))
+		return
+	}
+}
+
+func (r *OrgMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	orgId, email, ok := strings.Cut(req.ID, "/")
+	if !ok || orgId == "" || email == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <org_id>/<email>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_id"), orgId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), email)...)
+}
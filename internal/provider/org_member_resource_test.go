@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrgMemberResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if os.Getenv("APOLLO_MEMBER_EMAIL") == "" {
+				t.Skip("APOLLO_MEMBER_EMAIL must be set to an existing, removable member to run member acceptance tests")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccOrgMemberResourceConfig(os.Getenv("APOLLO_MEMBER_EMAIL"), "OBSERVER"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_org_member.test", "role", "OBSERVER"),
+					resource.TestCheckResourceAttrSet("apollo_org_member.test", "id"),
+					resource.TestCheckResourceAttrSet("apollo_org_member.test", "name"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "apollo_org_member.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     os.Getenv("APOLLO_ORG_ID") + "/" + os.Getenv("APOLLO_MEMBER_EMAIL"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccOrgMemberResourceConfig(os.Getenv("APOLLO_MEMBER_EMAIL"), "DOCUMENTER"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_org_member.test", "role", "DOCUMENTER"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccOrgMemberResource_notMember(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccOrgMemberResourceConfig("tf-acc-nobody@example.com", "OBSERVER"),
+				ExpectError: regexp.MustCompile(`Member Not Found`),
+			},
+		},
+	})
+}
+
+func testAccOrgMemberResourceConfig(email, role string) string {
+	return fmt.Sprintf(`
+resource "apollo_org_member" "test" {
+  org_id = %[1]q
+  email  = %[2]q
+  role   = %[3]q
+}
+`, os.Getenv("APOLLO_ORG_ID"), email, role)
+}
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &OrganizationDataSource{}
+
+func NewOrganizationDataSource() datasource.DataSource {
+	return &OrganizationDataSource{}
+}
+
+// OrganizationDataSource defines the data source implementation.
+type OrganizationDataSource struct {
+	client *client.Client
+}
+
+// OrganizationDataSourceModel describes the data source data model.
+type OrganizationDataSourceModel struct {
+	Id           types.String                   `tfsdk:"id"`
+	Name         types.String                   `tfsdk:"name"`
+	PlanName     types.String                   `tfsdk:"plan_name"`
+	PlanTier     types.String                   `tfsdk:"plan_tier"`
+	Enterprise   types.Bool                     `tfsdk:"enterprise"`
+	Capabilities *OrganizationCapabilitiesModel `tfsdk:"capabilities"`
+}
+
+// OrganizationCapabilitiesModel describes the features of the organization's plan.
+type OrganizationCapabilitiesModel struct {
+	Contracts        types.Bool `tfsdk:"contracts"`
+	CustomChecks     types.Bool `tfsdk:"custom_checks"`
+	Linting          types.Bool `tfsdk:"linting"`
+	PersistedQueries types.Bool `tfsdk:"persisted_queries"`
+}
+
+func (d *OrganizationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization"
+}
+
+func (d *OrganizationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Organization data source. Fails if the organization does not exist or the API key is not a member of it.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Organization ID for Apollo Studio",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the organization",
+				Computed:            true,
+			},
+			"plan_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the organization's billing plan",
+				Computed:            true,
+			},
+			"plan_tier": schema.StringAttribute{
+				MarkdownDescription: "Tier of the organization's billing plan, such as `FREE`, `TEAM` or `ENTERPRISE`",
+				Computed:            true,
+			},
+			"enterprise": schema.BoolAttribute{
+				MarkdownDescription: "Whether the organization is on an enterprise plan",
+				Computed:            true,
+			},
+			"capabilities": schema.SingleNestedAttribute{
+				MarkdownDescription: "Features enabled by the organization's plan",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"contracts": schema.BoolAttribute{
+						MarkdownDescription: "Whether contract variants are available",
+						Computed:            true,
+					},
+					"custom_checks": schema.BoolAttribute{
+						MarkdownDescription: "Whether custom schema checks are available",
+						Computed:            true,
+					},
+					"linting": schema.BoolAttribute{
+						MarkdownDescription: "Whether schema linting is available",
+						Computed:            true,
+					},
+					"persisted_queries": schema.BoolAttribute{
+						MarkdownDescription: "Whether persisted query lists are available",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *OrganizationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *OrganizationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OrganizationDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org, err := d.client.GetOrganization(ctx, data.Id.ValueString())
+	if client.IsNotFound(err) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Organization Not Found",
+			fmt.Sprintf("Organization %q does not exist or the configured API key is not a member of it.", data.Id.ValueString()),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organization, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(org.ID)
+	data.Name = types.StringValue(org.Name)
+	data.Enterprise = types.BoolValue(org.Enterprise())
+	data.PlanName = types.StringNull()
+	data.PlanTier = types.StringNull()
+	data.Capabilities = &OrganizationCapabilitiesModel{
+		Contracts:        types.BoolValue(false),
+		CustomChecks:     types.BoolValue(false),
+		Linting:          types.BoolValue(false),
+		PersistedQueries: types.BoolValue(false),
+	}
+	if plan := org.CurrentPlan; plan != nil {
+		data.PlanName = types.StringValue(plan.Name)
+		data.PlanTier = types.StringValue(plan.Tier)
+		data.Capabilities = &OrganizationCapabilitiesModel{
+			Contracts:        types.BoolValue(plan.Capabilities.Contracts),
+			CustomChecks:     types.BoolValue(plan.Capabilities.CustomChecks),
+			Linting:          types.BoolValue(plan.Capabilities.Linting),
+			PersistedQueries: types.BoolValue(plan.Capabilities.PersistedQueries),
+		}
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read an organization", map[string]interface{}{"id": org.ID})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
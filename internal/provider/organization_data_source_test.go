@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrganizationDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccOrganizationDataSourceConfig(os.Getenv("APOLLO_ORG_ID")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.apollo_organization.test", "id", os.Getenv("APOLLO_ORG_ID")),
+					resource.TestCheckResourceAttrSet("data.apollo_organization.test", "name"),
+					resource.TestCheckResourceAttrSet("data.apollo_organization.test", "plan_tier"),
+					resource.TestCheckResourceAttrSet("data.apollo_organization.test", "capabilities.contracts"),
+				),
+			},
+			{
+				Config:      testAccOrganizationDataSourceConfig("tf-acc-missing-org"),
+				ExpectError: regexp.MustCompile(`Organization Not Found`),
+			},
+		},
+	})
+}
+
+func testAccOrganizationDataSourceConfig(orgId string) string {
+	return fmt.Sprintf(`
+data "apollo_organization" "test" {
+  id = %[1]q
+}
+`, orgId)
+}
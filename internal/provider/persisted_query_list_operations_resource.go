@@ -0,0 +1,411 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/client"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/helpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PersistedQueryListOperationsResource{}
+var _ resource.ResourceWithConfigValidators = &PersistedQueryListOperationsResource{}
+var _ resource.ResourceWithModifyPlan = &PersistedQueryListOperationsResource{}
+var _ resource.ResourceWithImportState = &PersistedQueryListOperationsResource{}
+
+func NewPersistedQueryListOperationsResource() resource.Resource {
+	return &PersistedQueryListOperationsResource{}
+}
+
+// PersistedQueryListOperationsResource defines the resource implementation.
+type PersistedQueryListOperationsResource struct {
+	client *client.Client
+}
+
+// PersistedQueryListOperationsResourceModel describes the resource data model.
+type PersistedQueryListOperationsResourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	GraphId      types.String `tfsdk:"graph_id"`
+	ListId       types.String `tfsdk:"list_id"`
+	Manifest     types.String `tfsdk:"manifest"`
+	ManifestFile types.String `tfsdk:"manifest_file"`
+	Operations   types.Map    `tfsdk:"operations"`
+}
+
+// PersistedOperationModel describes a single operation of the list.
+type PersistedOperationModel struct {
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+	Body types.String `tfsdk:"body"`
+}
+
+// persistedOperationType is the object type of the elements of operations.
+var persistedOperationType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"name": types.StringType,
+	"type": types.StringType,
+	"body": types.StringType,
+}}
+
+func (r *PersistedQueryListOperationsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_persisted_query_list_operations"
+}
+
+func (r *PersistedQueryListOperationsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Syncs the operations of a persisted query list from an operation manifest. " +
+			"Operations missing from the manifest are removed from the list, and plans show every added, changed or removed operation. " +
+			"Destroying this resource removes every operation from the list.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the resource, in the form `<graph_id>/<list_id>`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"graph_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the graph the list belongs to. Changing this forces a new resource to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"list_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the persisted query list. Changing this forces a new resource to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"manifest": schema.StringAttribute{
+				MarkdownDescription: "Contents of an operation manifest, as generated by `@apollo/generate-persisted-query-manifest`. Exactly one of `manifest` and `manifest_file` must be set.",
+				Optional:            true,
+			},
+			"manifest_file": schema.StringAttribute{
+				MarkdownDescription: "Path to an operation manifest file. Exactly one of `manifest` and `manifest_file` must be set.",
+				Optional:            true,
+			},
+			"operations": schema.MapNestedAttribute{
+				MarkdownDescription: "Operations of the list, keyed by operation ID",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the operation",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the operation, one of `query`, `mutation` or `subscription`",
+							Computed:            true,
+						},
+						"body": schema.StringAttribute{
+							MarkdownDescription: "Body of the operation",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *PersistedQueryListOperationsResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("manifest"),
+			path.MatchRoot("manifest_file"),
+		),
+	}
+}
+
+func (r *PersistedQueryListOperationsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PersistedQueryListOperationsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan PersistedQueryListOperationsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var manifest []byte
+	attribute := path.Root("manifest")
+	switch {
+	case !plan.ManifestFile.IsNull() && !plan.ManifestFile.IsUnknown():
+		attribute = path.Root("manifest_file")
+		data, err := os.ReadFile(plan.ManifestFile.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(attribute, "Unable to read manifest file", err.Error())
+			return
+		}
+		manifest = data
+	case !plan.Manifest.IsNull() && !plan.Manifest.IsUnknown():
+		manifest = []byte(plan.Manifest.ValueString())
+	default:
+		// The manifest is not known until apply.
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("operations"), types.MapUnknown(persistedOperationType))...)
+		return
+	}
+
+	operations, err := helpers.ParseOperationManifest(manifest)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(attribute, "Invalid Operation Manifest", err.Error())
+		return
+	}
+
+	manifestOperations := make([]client.PersistedOperation, 0, len(operations))
+	for _, operation := range operations {
+		manifestOperations = append(manifestOperations, client.PersistedOperation(operation))
+	}
+
+	planned, diags := persistedOperationsValue(ctx, manifestOperations)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("operations"), planned)...)
+}
+
+func (r *PersistedQueryListOperationsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PersistedQueryListOperationsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(data.GraphId.ValueString() + "/" + data.ListId.ValueString())
+
+	resp.Diagnostics.Append(r.sync(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "synced persisted query list operations", map[string]interface{}{"id": data.Id.ValueString(), "count": len(data.Operations.Elements())})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PersistedQueryListOperationsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PersistedQueryListOperationsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operations, err := r.client.ListPersistedOperations(ctx, data.GraphId.ValueString(), data.ListId.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "persisted query list no longer exists, removing from state", map[string]interface{}{"id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read persisted query list operations, got error: %s", err))
+		return
+	}
+
+	var diags diag.Diagnostics
+	data.Operations, diags = persistedOperationsValue(ctx, operations)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PersistedQueryListOperationsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state PersistedQueryListOperationsResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = state.Id
+
+	resp.Diagnostics.Append(r.sync(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PersistedQueryListOperationsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PersistedQueryListOperationsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.client.ListPersistedOperations(ctx, data.GraphId.ValueString(), data.ListId.ValueString())
+	if client.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read persisted query list operations, got error: %s", err))
+		return
+	}
+	if len(current) == 0 {
+		return
+	}
+
+	remove := make([]string, 0, len(current))
+	for _, operation := range current {
+		remove = append(remove, operation.ID)
+	}
+
+	err = r.client.PublishPersistedOperations(ctx, data.GraphId.ValueString(), data.ListId.ValueString(), nil, remove)
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove persisted query list operations, got error: %s", err))
+		return
+	}
+}
+
+func (r *PersistedQueryListOperationsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	graphId, listId, ok := strings.Cut(req.ID, "/")
+	if !ok || graphId == "" || listId == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <graph_id>/<list_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("graph_id"), graphId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("list_id"), listId)...)
+}
+
+// sync makes the operations of the list match the planned operations of the
+// model, publishing new and changed operations and removing the rest in a
+// single revision.
+func (r *PersistedQueryListOperationsResource) sync(ctx context.Context, data *PersistedQueryListOperationsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	planned := make(map[string]PersistedOperationModel, len(data.Operations.Elements()))
+	diags.Append(data.Operations.ElementsAs(ctx, &planned, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	current, err := r.client.ListPersistedOperations(ctx, data.GraphId.ValueString(), data.ListId.ValueString())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read persisted query list operations, got error: %s", err))
+		return diags
+	}
+
+	existing := make(map[string]client.PersistedOperation, len(current))
+	for _, operation := range current {
+		existing[operation.ID] = operation
+	}
+
+	var publish []client.PersistedOperation
+	for id, operation := range planned {
+		wanted := client.PersistedOperation{
+			ID:   id,
+			Name: operation.Name.ValueString(),
+			Type: operation.Type.ValueString(),
+			Body: operation.Body.ValueString(),
+		}
+		if existing[id] != wanted {
+			publish = append(publish, wanted)
+		}
+	}
+
+	var remove []string
+	for id := range existing {
+		if _, ok := planned[id]; !ok {
+			remove = append(remove, id)
+		}
+	}
+
+	if len(publish) == 0 && len(remove) == 0 {
+		return diags
+	}
+
+	// Publish in a stable order so that revisions are reproducible.
+	sort.Slice(publish, func(i, j int) bool { return publish[i].ID < publish[j].ID })
+	sort.Strings(remove)
+
+	tflog.Debug(ctx, "publishing persisted query list operations", map[string]interface{}{"id": data.Id.ValueString(), "publish": len(publish), "remove": len(remove)})
+
+	err = r.client.PublishPersistedOperations(ctx, data.GraphId.ValueString(), data.ListId.ValueString(), publish, remove)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to publish persisted query list operations, got error: %s", err))
+	}
+
+	return diags
+}
+
+// persistedOperationsValue converts operations into the value of the
+// operations attribute.
+func persistedOperationsValue(ctx context.Context, operations []client.PersistedOperation) (types.Map, diag.Diagnostics) {
+	values := make(map[string]PersistedOperationModel, len(operations))
+	for _, operation := range operations {
+		values[operation.ID] = PersistedOperationModel{
+			Name: types.StringValue(operation.Name),
+			Type: types.StringValue(operation.Type),
+			Body: types.StringValue(operation.Body),
+		}
+	}
+
+	return types.MapValueFrom(ctx, persistedOperationType, values)
+}
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccPersistedQueryListOperationsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccPersistedQueryListOperationsResourceConfig(`
+    { id = "op-user", name = "GetUser", type = "query", body = "query GetUser { user { id } }" },
+    { id = "op-logout", name = "Logout", type = "mutation", body = "mutation Logout { logout }" },`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_persisted_query_list_operations.test", "operations.%", "2"),
+					resource.TestCheckResourceAttr("apollo_persisted_query_list_operations.test", "operations.op-user.name", "GetUser"),
+					resource.TestCheckResourceAttr("apollo_persisted_query_list_operations.test", "operations.op-logout.type", "mutation"),
+				),
+			},
+			// Removing an operation from the manifest removes it from the list
+			{
+				Config: testAccPersistedQueryListOperationsResourceConfig(`
+    { id = "op-user", name = "GetUser", type = "query", body = "query GetUser { user { id name } }" },`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("apollo_persisted_query_list_operations.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_persisted_query_list_operations.test", "operations.%", "1"),
+					resource.TestCheckResourceAttr("apollo_persisted_query_list_operations.test", "operations.op-user.body", "query GetUser { user { id name } }"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccPersistedQueryListOperationsResource_invalidManifest(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "apollo_persisted_query_list_operations" "test" {
+  graph_id = "my-graph"
+  list_id  = "my-list"
+  manifest = jsonencode({ format = "apollo-persisted-query-manifest", version = 2, operations = [] })
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Operation Manifest`),
+			},
+		},
+	})
+}
+
+func testAccPersistedQueryListOperationsResourceConfig(operations string) string {
+	return fmt.Sprintf(`
+resource "apollo_graph" "test" {
+  org_id     = %[1]q
+  graph_name = "tf-acc-pql-operations-graph"
+}
+
+resource "apollo_persisted_query_list" "test" {
+  graph_id = apollo_graph.test.graph_id
+  name     = "tf-acc-operations"
+}
+
+resource "apollo_persisted_query_list_operations" "test" {
+  graph_id = apollo_graph.test.graph_id
+  list_id  = apollo_persisted_query_list.test.id
+  manifest = jsonencode({
+    format  = "apollo-persisted-query-manifest"
+    version = 1
+    operations = [%[2]s
+    ]
+  })
+}
+`, os.Getenv("APOLLO_ORG_ID"), operations)
+}
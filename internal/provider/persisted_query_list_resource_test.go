@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccPersistedQueryListResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccPersistedQueryListResourceConfig("tf-acc-list", `[]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_persisted_query_list.test", "name", "tf-acc-list"),
+					resource.TestCheckResourceAttr("apollo_persisted_query_list.test", "linked_variants.#", "0"),
+					resource.TestCheckResourceAttrSet("apollo_persisted_query_list.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "apollo_persisted_query_list.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					attributes := s.RootModule().Resources["apollo_persisted_query_list.test"].Primary.Attributes
+					return attributes["graph_id"] + "/" + attributes["id"], nil
+				},
+			},
+			// Update and Read testing
+			{
+				Config: testAccPersistedQueryListResourceConfig("tf-acc-list-renamed", `[apollo_graph_variant.test.name]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_persisted_query_list.test", "name", "tf-acc-list-renamed"),
+					resource.TestCheckTypeSetElemAttr("apollo_persisted_query_list.test", "linked_variants.*", "staging"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccPersistedQueryListResourceConfig(name, linkedVariants string) string {
+	return fmt.Sprintf(`
+resource "apollo_graph" "test" {
+  org_id     = %[1]q
+  graph_name = "tf-acc-pql-graph"
+}
+
+resource "apollo_graph_variant" "test" {
+  graph_id = apollo_graph.test.graph_id
+  name     = "staging"
+}
+
+resource "apollo_persisted_query_list" "test" {
+  graph_id        = apollo_graph.test.graph_id
+  name            = %[2]q
+  linked_variants = %[3]s
+}
+`, os.Getenv("APOLLO_ORG_ID"), name, linkedVariants)
+}
@@ -5,6 +5,9 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/url"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -29,6 +32,7 @@ type ApolloProvider struct {
 // ApolloProviderModel describes the provider data model. - Reflects the schema
 type ApolloProviderModel struct {
 	PersonalApiKey types.String `tfsdk:"personal_api_key"`
+	Endpoint       types.String `tfsdk:"endpoint"`
 }
 
 func (p *ApolloProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -40,8 +44,13 @@ func (p *ApolloProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"personal_api_key": schema.StringAttribute{
-				MarkdownDescription: "User's personal Apollo API key",
-				Required:            true,
+				MarkdownDescription: "User's personal Apollo API key. May also be provided via the `APOLLO_KEY` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"endpoint": schema.StringAttribute{
+				MarkdownDescription: "URL of the Apollo Platform API. May also be provided via the `APOLLO_ENDPOINT` environment variable. Defaults to `" + client.DefaultEndpoint + "`.",
+				Optional:            true,
 			},
 		},
 	}
@@ -56,27 +65,79 @@ func (p *ApolloProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
-	// Configuration values are now available.
-	// if data.Endpoint.IsNull() { /* ... */ }
 	if data.PersonalApiKey.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("personal_api_key"),
 			"Unknown apollo api key",
-			"The provider cannot connect to the Apollo client because there is an unknown configuration value for the personal api key.",
+			"The provider cannot connect to the Apollo client because there is an unknown configuration value for the personal api key. "+
+				"Either set the value statically in the configuration, or use the APOLLO_KEY environment variable.",
+		)
+	}
+
+	if data.Endpoint.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("endpoint"),
+			"Unknown apollo endpoint",
+			"The provider cannot connect to the Apollo client because there is an unknown configuration value for the endpoint. "+
+				"Either set the value statically in the configuration, or use the APOLLO_ENDPOINT environment variable.",
 		)
 	}
 
-	if data.PersonalApiKey.ValueString() == "" {
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Configuration values take precedence over environment variables.
+	apiKey := os.Getenv("APOLLO_KEY")
+	if !data.PersonalApiKey.IsNull() {
+		apiKey = data.PersonalApiKey.ValueString()
+	}
+
+	endpoint := os.Getenv("APOLLO_ENDPOINT")
+	if !data.Endpoint.IsNull() {
+		endpoint = data.Endpoint.ValueString()
+	}
+
+	if apiKey == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("personal_api_key"),
 			"Missing apollo api key",
-			"The provider cannot connect to the Apollo client because there is a missing configuration value for the personal api key.",
+			"The provider cannot connect to the Apollo client because there is a missing configuration value for the personal api key. "+
+				"Set the personal_api_key value in the configuration or use the APOLLO_KEY environment variable.",
 		)
 	}
 
+	if endpoint != "" {
+		if u, err := url.Parse(endpoint); err != nil || u.Scheme == "" || u.Host == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("endpoint"),
+				"Invalid apollo endpoint",
+				fmt.Sprintf("The endpoint %q is not an absolute URL.", endpoint),
+			)
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	Apollo := &client.Client{
-		ApiKey: data.PersonalApiKey.ValueString(),
+		ApiKey:   apiKey,
+		Endpoint: endpoint,
+	}
+	Apollo.Init()
+
+	// Contract variants are gated on this flag. When the lookup fails they are
+	// left to the Apollo API to reject rather than failing every plan.
+	enterprise, err := Apollo.HasContractsCapability(ctx)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to detect enterprise features",
+			fmt.Sprintf("Contract variants will not be checked against the plans of your organizations before they are created, got error: %s", err),
+		)
+		enterprise = true
 	}
+	Apollo.EnterPriseEnabled = enterprise
 
 	resp.DataSourceData = Apollo
 	resp.ResourceData = Apollo
@@ -86,12 +147,31 @@ func (p *ApolloProvider) Resources(ctx context.Context) []func() resource.Resour
 	return []func() resource.Resource{
 		NewGraphResource,
 		NewApiKeyResource,
+		NewGraphVariantResource,
+		NewSubgraphResource,
+		NewContractVariantResource,
+		NewOrgMemberResource,
+		NewOrgInvitationResource,
+		NewGraphAccessResource,
+		NewNotificationChannelResource,
+		NewGraphNotificationResource,
+		NewPersistedQueryListResource,
+		NewPersistedQueryListOperationsResource,
+		NewVariantCheckConfigurationResource,
+		NewGraphLintConfigurationResource,
+		NewCustomCheckConfigurationResource,
+		NewVariantBuildConfigResource,
+		NewCloudRouterResource,
+		NewRouterSecretResource,
 	}
 }
 
 func (p *ApolloProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
-		NewExampleDataSource,
+		NewGraphDataSource,
+		NewSchemaCheckDataSource,
+		NewOrganizationDataSource,
+		NewGraphsDataSource,
 	}
 }
 
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRouterSecretResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if os.Getenv("APOLLO_CLOUD_ROUTER_GRAPH_ID") == "" {
+				t.Skip("APOLLO_CLOUD_ROUTER_GRAPH_ID must be set to a graph served by a cloud router to run router secret acceptance tests")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRouterSecretResourceConfig("one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_router_secret.test", "variant", "current"),
+					resource.TestCheckResourceAttr("apollo_router_secret.test", "name", "TF_ACC_ROUTER_SECRET"),
+					resource.TestCheckResourceAttr("apollo_router_secret.test", "id", os.Getenv("APOLLO_CLOUD_ROUTER_GRAPH_ID")+"@current/TF_ACC_ROUTER_SECRET"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "apollo_router_secret.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The value and triggers only exist in the configuration.
+				ImportStateVerifyIgnore: []string{"value", "rotation_triggers"},
+			},
+			// Rotation testing
+			{
+				Config: testAccRouterSecretResourceConfig("two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_router_secret.test", "rotation_triggers.rotation", "two"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccRouterSecretResourceConfig(rotation string) string {
+	return fmt.Sprintf(`
+resource "apollo_router_secret" "test" {
+  graph_id = %[1]q
+  name     = "TF_ACC_ROUTER_SECRET"
+  value    = "tf-acc-secret"
+
+  rotation_triggers = {
+    rotation = %[2]q
+  }
+}
+`, os.Getenv("APOLLO_CLOUD_ROUTER_GRAPH_ID"), rotation)
+}
@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SchemaCheckDataSource{}
+
+func NewSchemaCheckDataSource() datasource.DataSource {
+	return &SchemaCheckDataSource{}
+}
+
+// SchemaCheckDataSource defines the data source implementation.
+type SchemaCheckDataSource struct {
+	client *client.Client
+}
+
+// SchemaCheckDataSourceModel describes the data source data model.
+type SchemaCheckDataSourceModel struct {
+	Id                  types.String                  `tfsdk:"id"`
+	GraphId             types.String                  `tfsdk:"graph_id"`
+	Variant             types.String                  `tfsdk:"variant"`
+	SubgraphName        types.String                  `tfsdk:"subgraph_name"`
+	Sdl                 types.String                  `tfsdk:"sdl"`
+	FailOnFailure       types.Bool                    `tfsdk:"fail_on_failure"`
+	Passed              types.Bool                    `tfsdk:"passed"`
+	BreakingChangeCount types.Int64                   `tfsdk:"breaking_change_count"`
+	Changes             []SchemaCheckChangeModel      `tfsdk:"changes"`
+	AffectedOperations  []SchemaCheckOperationModel   `tfsdk:"affected_operations"`
+	CompositionErrors   []SchemaCheckCompositionModel `tfsdk:"composition_errors"`
+	WorkflowUrl         types.String                  `tfsdk:"workflow_url"`
+}
+
+// SchemaCheckChangeModel describes a single schema change.
+type SchemaCheckChangeModel struct {
+	Code        types.String `tfsdk:"code"`
+	Severity    types.String `tfsdk:"severity"`
+	Description types.String `tfsdk:"description"`
+}
+
+// SchemaCheckOperationModel describes an operation affected by the check.
+type SchemaCheckOperationModel struct {
+	Id   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+// SchemaCheckCompositionModel describes a composition error found by the check.
+type SchemaCheckCompositionModel struct {
+	Code    types.String `tfsdk:"code"`
+	Message types.String `tfsdk:"message"`
+}
+
+func (d *SchemaCheckDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema_check"
+}
+
+func (d *SchemaCheckDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Runs a schema check of a proposed subgraph schema against a graph variant. " +
+			"A new check is run every time the data source is read.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the check workflow",
+				Computed:            true,
+			},
+			"graph_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the graph to check against",
+				Required:            true,
+			},
+			"variant": schema.StringAttribute{
+				MarkdownDescription: "Variant to check against. Defaults to `current`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"subgraph_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the subgraph whose schema is proposed",
+				Required:            true,
+			},
+			"sdl": schema.StringAttribute{
+				MarkdownDescription: "Proposed schema of the subgraph",
+				Required:            true,
+			},
+			"fail_on_failure": schema.BoolAttribute{
+				MarkdownDescription: "Whether a failed check is reported as an error, failing the plan. Defaults to `false`.",
+				Optional:            true,
+			},
+			"passed": schema.BoolAttribute{
+				MarkdownDescription: "Whether the check found no breaking changes and no composition errors",
+				Computed:            true,
+			},
+			"breaking_change_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of changes with `FAILURE` severity",
+				Computed:            true,
+			},
+			"changes": schema.ListNestedAttribute{
+				MarkdownDescription: "Changes between the proposed schema and the schema of the variant",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"code": schema.StringAttribute{
+							MarkdownDescription: "Kind of change, such as `FIELD_REMOVED`",
+							Computed:            true,
+						},
+						"severity": schema.StringAttribute{
+							MarkdownDescription: "Severity of the change, `FAILURE` for breaking changes or `NOTICE`",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Human-readable description of the change",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"affected_operations": schema.ListNestedAttribute{
+				MarkdownDescription: "Client operations affected by the changes",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "ID of the operation",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the operation, if it has one",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"composition_errors": schema.ListNestedAttribute{
+				MarkdownDescription: "Errors composing the proposed schema with the other subgraphs of the variant",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"code": schema.StringAttribute{
+							MarkdownDescription: "Composition error code",
+							Computed:            true,
+						},
+						"message": schema.StringAttribute{
+							MarkdownDescription: "Composition error message",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"workflow_url": schema.StringAttribute{
+				MarkdownDescription: "Link to the check workflow in Apollo Studio",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *SchemaCheckDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SchemaCheckDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SchemaCheckDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Variant.IsNull() {
+		data.Variant = types.StringValue("current")
+	}
+
+	result, err := d.client.CheckSubgraphSchema(ctx, data.GraphId.ValueString(), data.Variant.ValueString(), data.SubgraphName.ValueString(), data.Sdl.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to run schema check, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(result.WorkflowID)
+	data.Passed = types.BoolValue(result.Passed())
+	data.BreakingChangeCount = types.Int64Value(int64(result.BreakingChanges()))
+	data.WorkflowUrl = types.StringValue(result.TargetURL)
+
+	data.Changes = make([]SchemaCheckChangeModel, 0, len(result.Changes))
+	for _, change := range result.Changes {
+		data.Changes = append(data.Changes, SchemaCheckChangeModel{
+			Code:        types.StringValue(change.Code),
+			Severity:    types.StringValue(change.Severity),
+			Description: types.StringValue(change.Description),
+		})
+	}
+
+	data.AffectedOperations = make([]SchemaCheckOperationModel, 0, len(result.AffectedQueries))
+	for _, operation := range result.AffectedQueries {
+		data.AffectedOperations = append(data.AffectedOperations, SchemaCheckOperationModel{
+			Id:   types.StringValue(operation.ID),
+			Name: types.StringPointerValue(operation.Name),
+		})
+	}
+
+	data.CompositionErrors = make([]SchemaCheckCompositionModel, 0, len(result.CompositionErrors))
+	for _, compositionError := range result.CompositionErrors {
+		data.CompositionErrors = append(data.CompositionErrors, SchemaCheckCompositionModel{
+			Code:    types.StringValue(compositionError.Code),
+			Message: types.StringValue(compositionError.Message),
+		})
+	}
+
+	if data.FailOnFailure.ValueBool() && !result.Passed() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("sdl"),
+			"Schema Check Failed",
+			fmt.Sprintf("The proposed schema of subgraph %q has %d breaking change(s) and %d composition error(s). See %s for details.",
+				data.SubgraphName.ValueString(), result.BreakingChanges(), len(result.CompositionErrors), result.TargetURL),
+		)
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "ran a schema check", map[string]interface{}{"workflow_id": result.WorkflowID, "passed": result.Passed()})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSchemaCheckDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccSchemaCheckDataSourceConfig(testAccSubgraphSdl, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.apollo_schema_check.test", "passed", "true"),
+					resource.TestCheckResourceAttr("data.apollo_schema_check.test", "breaking_change_count", "0"),
+					resource.TestCheckResourceAttrSet("data.apollo_schema_check.test", "workflow_url"),
+				),
+			},
+			// Removing a field is a breaking change
+			{
+				Config:      testAccSchemaCheckDataSourceConfig("type Query { product(id: ID!): String }", true),
+				ExpectError: regexp.MustCompile(`Schema Check Failed`),
+			},
+		},
+	})
+}
+
+func testAccSchemaCheckDataSourceConfig(sdl string, failOnFailure bool) string {
+	return fmt.Sprintf(`
+resource "apollo_graph" "test" {
+  org_id     = %[1]q
+  graph_name = "tf-acc-check-graph"
+}
+
+resource "apollo_subgraph" "test" {
+  graph_id    = apollo_graph.test.graph_id
+  name        = "products"
+  routing_url = "https://products.example.com/graphql"
+  sdl         = %[2]q
+}
+
+data "apollo_schema_check" "test" {
+  graph_id        = apollo_subgraph.test.graph_id
+  subgraph_name   = apollo_subgraph.test.name
+  sdl             = %[3]q
+  fail_on_failure = %[4]t
+}
+`, os.Getenv("APOLLO_ORG_ID"), testAccSubgraphSdl, sdl, failOnFailure)
+}
@@ -0,0 +1,333 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/client"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/helpers"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SubgraphResource{}
+var _ resource.ResourceWithImportState = &SubgraphResource{}
+var _ resource.ResourceWithConfigValidators = &SubgraphResource{}
+var _ resource.ResourceWithModifyPlan = &SubgraphResource{}
+
+// subgraphRevision is the revision recorded by Apollo for schemas published
+// by the provider.
+const subgraphRevision = "terraform"
+
+func NewSubgraphResource() resource.Resource {
+	return &SubgraphResource{}
+}
+
+// SubgraphResource defines the resource implementation.
+type SubgraphResource struct {
+	client *client.Client
+}
+
+// SubgraphResourceModel describes the resource data model.
+type SubgraphResourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	GraphId    types.String `tfsdk:"graph_id"`
+	Variant    types.String `tfsdk:"variant"`
+	Name       types.String `tfsdk:"name"`
+	RoutingUrl types.String `tfsdk:"routing_url"`
+	Sdl        types.String `tfsdk:"sdl"`
+	SdlFile    types.String `tfsdk:"sdl_file"`
+}
+
+func (r *SubgraphResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subgraph"
+}
+
+func (r *SubgraphResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Federated subgraph resource. Publishing a schema that fails composition is reported as an error.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the subgraph, in the form `<graph_id>@<variant>/<name>`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"graph_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the graph the subgraph is published to. Changing this forces a new subgraph to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"variant": schema.StringAttribute{
+				MarkdownDescription: "Variant the subgraph is published to. Defaults to `current`. Changing this forces a new subgraph to be created.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("current"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the subgraph. Changing this forces a new subgraph to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"routing_url": schema.StringAttribute{
+				MarkdownDescription: "URL the router uses to reach the subgraph",
+				Required:            true,
+			},
+			"sdl": schema.StringAttribute{
+				MarkdownDescription: "Schema of the subgraph. Exactly one of `sdl` and `sdl_file` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"sdl_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file containing the schema of the subgraph. Its contents are read at plan time.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *SubgraphResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("sdl"),
+			path.MatchRoot("sdl_file"),
+		),
+	}
+}
+
+func (r *SubgraphResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SubgraphResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan SubgraphResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.SdlFile.IsNull() && !plan.SdlFile.IsUnknown() {
+		sdl, err := os.ReadFile(plan.SdlFile.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("sdl_file"), "Unable to read schema file", err.Error())
+			return
+		}
+		plan.Sdl = types.StringValue(string(sdl))
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("sdl"), plan.Sdl)...)
+	}
+
+	if r.client == nil || plan.GraphId.IsUnknown() || plan.Variant.IsUnknown() || plan.Name.IsUnknown() || plan.Sdl.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state SubgraphResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() || state.Sdl.Equal(plan.Sdl) {
+			return
+		}
+	}
+
+	compositionErrors, err := r.client.ValidateSubgraph(ctx, plan.GraphId.ValueString(), plan.Variant.ValueString(), plan.Name.ValueString(), plan.Sdl.ValueString())
+	if err != nil {
+		// The graph or variant may not exist until apply, so a failed
+		// validation must not block the plan.
+		tflog.Warn(ctx, "unable to validate subgraph composition", map[string]interface{}{"error": err.Error()})
+		return
+	}
+	resp.Diagnostics.Append(compositionDiagnostics(path.Root("sdl"), compositionErrors)...)
+}
+
+func (r *SubgraphResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SubgraphResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(client.SubgraphRef(data.GraphId.ValueString(), data.Variant.ValueString(), data.Name.ValueString()))
+
+	compositionErrors, err := r.publish(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to publish subgraph, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "published a subgraph", map[string]interface{}{"id": data.Id.ValueString()})
+
+	// Save data into Terraform state even if composition failed, since the
+	// schema has been published. Composition errors are only warnings here, as
+	// errors would taint the published subgraph.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(compositionWarnings(path.Root("sdl"), compositionErrors)...)
+}
+
+func (r *SubgraphResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SubgraphResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subgraph, err := r.client.GetSubgraph(ctx, data.GraphId.ValueString(), data.Variant.ValueString(), data.Name.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "subgraph no longer exists, removing from state", map[string]interface{}{"id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read subgraph, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(client.SubgraphRef(data.GraphId.ValueString(), data.Variant.ValueString(), subgraph.Name))
+	data.Name = types.StringValue(subgraph.Name)
+	data.RoutingUrl = types.StringValue(subgraph.URL)
+	// Apollo may reformat the published schema, so the configured one is kept
+	// unless it differs in more than whitespace and comments.
+	if data.Sdl.IsNull() || !helpers.SDLEqual(data.Sdl.ValueString(), subgraph.ActivePartialSchema.SDL) {
+		data.Sdl = types.StringValue(subgraph.ActivePartialSchema.SDL)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubgraphResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SubgraphResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	compositionErrors, err := r.publish(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to publish subgraph, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(compositionWarnings(path.Root("sdl"), compositionErrors)...)
+}
+
+func (r *SubgraphResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SubgraphResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	compositionErrors, err := r.client.RemoveSubgraph(ctx, data.GraphId.ValueString(), data.Variant.ValueString(), data.Name.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove subgraph, got error: %s", err))
+		return
+	}
+
+	// The subgraph is gone either way; composition problems of the remaining
+	// subgraphs are surfaced without failing the destroy.
+	for _, e := range compositionErrors {
+		resp.Diagnostics.AddWarning("Composition Error", e.String())
+	}
+}
+
+func (r *SubgraphResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	graphId, variant, name, err := client.ParseSubgraphRef(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("graph_id"), graphId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("variant"), variant)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+func (r *SubgraphResource) publish(ctx context.Context, data SubgraphResourceModel) ([]client.CompositionError, error) {
+	return r.client.PublishSubgraph(
+		ctx,
+		data.GraphId.ValueString(),
+		data.Variant.ValueString(),
+		data.Name.ValueString(),
+		data.RoutingUrl.ValueString(),
+		subgraphRevision,
+		data.Sdl.ValueString(),
+	)
+}
+
+// compositionDiagnostics converts composition errors into error diagnostics
+// on the given attribute.
+func compositionDiagnostics(attribute path.Path, compositionErrors []client.CompositionError) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, e := range compositionErrors {
+		diags.AddAttributeError(attribute, "Composition Error", e.String())
+	}
+	return diags
+}
+
+// compositionWarnings converts composition errors into warning diagnostics on
+// the given attribute, for schemas that have already been published.
+func compositionWarnings(attribute path.Path, compositionErrors []client.CompositionError) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, e := range compositionErrors {
+		diags.AddAttributeWarning(attribute, "Composition Error", e.String())
+	}
+	return diags
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testAccSubgraphSdl = `extend schema @link(url: "https://specs.apollo.dev/federation/v2.3", import: ["@key"])
+
+type Product @key(fields: "id") {
+  id: ID!
+  name: String!
+}
+
+type Query {
+  product(id: ID!): Product
+}
+`
+
+func TestAccSubgraphResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSubgraphResourceConfigBothSchemas,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			// Create and Read testing
+			{
+				Config: testAccSubgraphResourceConfig("https://products.example.com/graphql"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_subgraph.test", "variant", "current"),
+					resource.TestCheckResourceAttr("apollo_subgraph.test", "routing_url", "https://products.example.com/graphql"),
+					resource.TestCheckResourceAttr("apollo_subgraph.test", "sdl", testAccSubgraphSdl),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "apollo_subgraph.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccSubgraphResourceConfig("https://products-v2.example.com/graphql"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_subgraph.test", "routing_url", "https://products-v2.example.com/graphql"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccSubgraphResourceConfig(routingUrl string) string {
+	return fmt.Sprintf(`
+resource "apollo_graph" "test" {
+  org_id     = %[1]q
+  graph_name = "tf-acc-subgraph-graph"
+}
+
+resource "apollo_subgraph" "test" {
+  graph_id    = apollo_graph.test.graph_id
+  name        = "products"
+  routing_url = %[2]q
+  sdl         = %[3]q
+}
+`, os.Getenv("APOLLO_ORG_ID"), routingUrl, testAccSubgraphSdl)
+}
+
+const testAccSubgraphResourceConfigBothSchemas = `
+resource "apollo_subgraph" "test" {
+  graph_id    = "unused"
+  name        = "products"
+  routing_url = "https://products.example.com/graphql"
+  sdl         = "type Query { ok: Boolean }"
+  sdl_file    = "schema.graphql"
+}
+`
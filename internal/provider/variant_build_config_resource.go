@@ -0,0 +1,248 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &VariantBuildConfigResource{}
+var _ resource.ResourceWithImportState = &VariantBuildConfigResource{}
+
+func NewVariantBuildConfigResource() resource.Resource {
+	return &VariantBuildConfigResource{}
+}
+
+// VariantBuildConfigResource defines the resource implementation.
+type VariantBuildConfigResource struct {
+	client *client.Client
+}
+
+// VariantBuildConfigResourceModel describes the resource data model.
+type VariantBuildConfigResourceModel struct {
+	Id                 types.String `tfsdk:"id"`
+	GraphId            types.String `tfsdk:"graph_id"`
+	Variant            types.String `tfsdk:"variant"`
+	BuildPipelineTrack types.String `tfsdk:"build_pipeline_track"`
+	LaunchId           types.String `tfsdk:"launch_id"`
+	LaunchStatus       types.String `tfsdk:"launch_status"`
+	BuildErrors        types.List   `tfsdk:"build_errors"`
+}
+
+func (r *VariantBuildConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_variant_build_config"
+}
+
+func (r *VariantBuildConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Composition build pipeline of a graph variant. Destroying the resource leaves the variant on its current track.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Graph ref of the variant, in the form `<graph_id>@<variant>`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"graph_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the graph. Changing this forces a new resource to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"variant": schema.StringAttribute{
+				MarkdownDescription: "Name of the variant. Defaults to `current`. Changing this forces a new resource to be created.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("current"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"build_pipeline_track": schema.StringAttribute{
+				MarkdownDescription: "Federation version used to compose the supergraph, one of `" + strings.Join(client.BuildPipelineTracks, "`, `") + "`. `FED_1` and `FED_2` follow the latest release of their major version. Changing the track starts a new launch.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.BuildPipelineTracks...),
+				},
+			},
+			"launch_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the latest launch of the variant",
+				Computed:            true,
+			},
+			"launch_status": schema.StringAttribute{
+				MarkdownDescription: "Status of the latest launch, such as `LAUNCH_INITIATED`, `LAUNCH_COMPLETED` or `LAUNCH_FAILED`",
+				Computed:            true,
+			},
+			"build_errors": schema.ListAttribute{
+				MarkdownDescription: "Composition errors that failed the build of the latest launch",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *VariantBuildConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VariantBuildConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VariantBuildConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.client.UpdateBuildPipelineTrack(ctx, data.GraphId.ValueString(), data.Variant.ValueString(), data.BuildPipelineTrack.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set build pipeline track, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "set build pipeline track", map[string]interface{}{"id": data.Id.ValueString(), "track": data.BuildPipelineTrack.ValueString()})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(buildErrorWarnings("Build Error", data.BuildErrors)...)
+}
+
+func (r *VariantBuildConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VariantBuildConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.client.GetBuildConfig(ctx, data.GraphId.ValueString(), data.Variant.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "graph variant no longer exists, removing build config from state", map[string]interface{}{"id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read build config, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VariantBuildConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VariantBuildConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.client.UpdateBuildPipelineTrack(ctx, data.GraphId.ValueString(), data.Variant.ValueString(), data.BuildPipelineTrack.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update build pipeline track, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(buildErrorWarnings("Build Error", data.BuildErrors)...)
+}
+
+func (r *VariantBuildConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// A variant always has a build pipeline track, so there is nothing to
+	// remove; the variant keeps the track it was last pinned to.
+}
+
+func (r *VariantBuildConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	graphId, name, err := client.ParseVariantRef(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("graph_id"), graphId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("variant"), name)...)
+}
+
+// refresh copies the API representation of the build config into the model.
+func (m *VariantBuildConfigResourceModel) refresh(ctx context.Context, config *client.BuildConfig) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Id = types.StringValue(client.VariantRef(m.GraphId.ValueString(), m.Variant.ValueString()))
+	m.BuildPipelineTrack = types.StringValue(config.BuildPipelineTrack)
+
+	m.LaunchId = types.StringNull()
+	m.LaunchStatus = types.StringNull()
+	if launch := config.LatestLaunch; launch != nil {
+		m.LaunchId = types.StringValue(launch.ID)
+		m.LaunchStatus = types.StringValue(launch.Status)
+	}
+
+	var d diag.Diagnostics
+	m.BuildErrors, d = types.ListValueFrom(ctx, types.StringType, nonNilStrings(config.LatestLaunch.BuildErrors()))
+	diags.Append(d...)
+
+	return diags
+}
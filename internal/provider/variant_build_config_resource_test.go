@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVariantBuildConfigResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccVariantBuildConfigResourceConfig("FED_2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_variant_build_config.test", "variant", "current"),
+					resource.TestCheckResourceAttr("apollo_variant_build_config.test", "build_pipeline_track", "FED_2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "apollo_variant_build_config.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccVariantBuildConfigResourceConfig("FED_2_9"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_variant_build_config.test", "build_pipeline_track", "FED_2_9"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccVariantBuildConfigResourceConfig(track string) string {
+	return fmt.Sprintf(`
+resource "apollo_graph" "test" {
+  org_id     = %[1]q
+  graph_name = "tf-acc-build-config-graph"
+}
+
+resource "apollo_variant_build_config" "test" {
+  graph_id             = apollo_graph.test.graph_id
+  build_pipeline_track = %[2]q
+}
+`, os.Getenv("APOLLO_ORG_ID"), track)
+}
@@ -0,0 +1,344 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/client"
+)
+
+// secondsPerDay converts between the time window in days exposed by the
+// resource and the time range in seconds used by Apollo.
+const secondsPerDay = 24 * 60 * 60
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &VariantCheckConfigurationResource{}
+var _ resource.ResourceWithImportState = &VariantCheckConfigurationResource{}
+
+func NewVariantCheckConfigurationResource() resource.Resource {
+	return &VariantCheckConfigurationResource{}
+}
+
+// VariantCheckConfigurationResource defines the resource implementation.
+type VariantCheckConfigurationResource struct {
+	client *client.Client
+}
+
+// VariantCheckConfigurationResourceModel describes the resource data model.
+type VariantCheckConfigurationResourceModel struct {
+	Id                     types.String `tfsdk:"id"`
+	GraphId                types.String `tfsdk:"graph_id"`
+	Variant                types.String `tfsdk:"variant"`
+	TimeWindowDays         types.Int64  `tfsdk:"time_window_days"`
+	TimeWindowSeconds      types.Int64  `tfsdk:"time_window_seconds"`
+	MinimumRequestCount    types.Int64  `tfsdk:"minimum_request_count"`
+	ExcludedClients        types.Set    `tfsdk:"excluded_clients"`
+	ExcludedOperationNames types.Set    `tfsdk:"excluded_operation_names"`
+	CheckedVariants        types.Set    `tfsdk:"checked_variants"`
+	LintingGatesCheck      types.Bool   `tfsdk:"linting_gates_check"`
+	ProposalsGateCheck     types.Bool   `tfsdk:"proposals_gate_check"`
+}
+
+func (r *VariantCheckConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_variant_check_configuration"
+}
+
+func (r *VariantCheckConfigurationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	emptySet := setdefault.StaticValue(types.SetValueMust(types.StringType, nil))
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Schema check configuration of a graph variant. Destroying the resource restores the default configuration.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Graph ref of the variant, in the form `<graph_id>@<variant>`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"graph_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the graph. Changing this forces a new resource to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"variant": schema.StringAttribute{
+				MarkdownDescription: "Name of the variant checks run against. Defaults to `current`. Changing this forces a new resource to be created.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("current"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"time_window_days": schema.Int64Attribute{
+				MarkdownDescription: "Number of days of operation usage considered by checks. Defaults to `7`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(7),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 90),
+				},
+			},
+			"time_window_seconds": schema.Int64Attribute{
+				MarkdownDescription: "Time window of checks in seconds, as stored by Apollo. Windows that are not whole days, such as ones set in hours in Apollo Studio, leave `time_window_days` empty so that they are planned back to it.",
+				Computed:            true,
+			},
+			"minimum_request_count": schema.Int64Attribute{
+				MarkdownDescription: "Minimum number of requests in the time window for a change affecting an operation to be flagged. Defaults to `1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"excluded_clients": schema.SetAttribute{
+				MarkdownDescription: "Names of the clients whose operations are ignored by checks",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             emptySet,
+			},
+			"excluded_operation_names": schema.SetAttribute{
+				MarkdownDescription: "Names of the operations ignored by checks",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             emptySet,
+			},
+			"checked_variants": schema.SetAttribute{
+				MarkdownDescription: "Names of the variants whose operation usage is checked against. Empty means the variant itself.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             emptySet,
+			},
+			"linting_gates_check": schema.BoolAttribute{
+				MarkdownDescription: "Whether linter errors fail the check. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"proposals_gate_check": schema.BoolAttribute{
+				MarkdownDescription: "Whether changes without an approved proposal fail the check. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *VariantCheckConfigurationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VariantCheckConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VariantCheckConfigurationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configuration, diags := data.configuration(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateCheckConfiguration(ctx, data.GraphId.ValueString(), data.Variant.ValueString(), configuration)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to configure schema checks, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, updated)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "configured schema checks", map[string]interface{}{"id": data.Id.ValueString()})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VariantCheckConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VariantCheckConfigurationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configuration, err := r.client.GetCheckConfiguration(ctx, data.GraphId.ValueString(), data.Variant.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "graph variant no longer exists, removing check configuration from state", map[string]interface{}{"id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schema check configuration, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, configuration)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VariantCheckConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VariantCheckConfigurationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configuration, diags := data.configuration(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateCheckConfiguration(ctx, data.GraphId.ValueString(), data.Variant.ValueString(), configuration)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update schema check configuration, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, updated)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VariantCheckConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VariantCheckConfigurationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.ResetCheckConfiguration(ctx, data.GraphId.ValueString(), data.Variant.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset schema check configuration, got error: %s", err))
+		return
+	}
+}
+
+func (r *VariantCheckConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	graphId, name, err := client.ParseVariantRef(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("graph_id"), graphId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("variant"), name)...)
+}
+
+// configuration converts the model into the check configuration sent to
+// Apollo.
+func (m *VariantCheckConfigurationResourceModel) configuration(ctx context.Context) (client.CheckConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	configuration := client.CheckConfiguration{
+		TimeRangeSeconds:        m.TimeWindowDays.ValueInt64() * secondsPerDay,
+		OperationCountThreshold: m.MinimumRequestCount.ValueInt64(),
+		LintFailsCheck:          m.LintingGatesCheck.ValueBool(),
+		ProposalFailsCheck:      m.ProposalsGateCheck.ValueBool(),
+	}
+	diags.Append(m.ExcludedClients.ElementsAs(ctx, &configuration.ExcludedClients, false)...)
+	diags.Append(m.ExcludedOperationNames.ElementsAs(ctx, &configuration.ExcludedOperationNames, false)...)
+	diags.Append(m.CheckedVariants.ElementsAs(ctx, &configuration.IncludedVariants, false)...)
+
+	configuration.ExcludedClients = nonNilStrings(configuration.ExcludedClients)
+	configuration.ExcludedOperationNames = nonNilStrings(configuration.ExcludedOperationNames)
+	configuration.IncludedVariants = nonNilStrings(configuration.IncludedVariants)
+
+	return configuration, diags
+}
+
+// refresh copies the API representation of the configuration into the model.
+func (m *VariantCheckConfigurationResourceModel) refresh(ctx context.Context, configuration *client.CheckConfiguration) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	m.Id = types.StringValue(client.VariantRef(m.GraphId.ValueString(), m.Variant.ValueString()))
+	m.TimeWindowSeconds = types.Int64Value(configuration.TimeRangeSeconds)
+	// A window that is not whole days cannot be expressed in days, so it is
+	// left null to make it differ from any configured value.
+	m.TimeWindowDays = types.Int64Null()
+	if configuration.TimeRangeSeconds%secondsPerDay == 0 {
+		m.TimeWindowDays = types.Int64Value(configuration.TimeRangeSeconds / secondsPerDay)
+	}
+	m.MinimumRequestCount = types.Int64Value(configuration.OperationCountThreshold)
+	m.LintingGatesCheck = types.BoolValue(configuration.LintFailsCheck)
+	m.ProposalsGateCheck = types.BoolValue(configuration.ProposalFailsCheck)
+
+	m.ExcludedClients, d = types.SetValueFrom(ctx, types.StringType, nonNilStrings(configuration.ExcludedClients))
+	diags.Append(d...)
+	m.ExcludedOperationNames, d = types.SetValueFrom(ctx, types.StringType, nonNilStrings(configuration.ExcludedOperationNames))
+	diags.Append(d...)
+	m.CheckedVariants, d = types.SetValueFrom(ctx, types.StringType, nonNilStrings(configuration.IncludedVariants))
+	diags.Append(d...)
+
+	return diags
+}
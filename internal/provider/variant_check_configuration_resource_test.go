@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVariantCheckConfigurationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccVariantCheckConfigurationResourceConfig(7, `[]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_variant_check_configuration.test", "variant", "current"),
+					resource.TestCheckResourceAttr("apollo_variant_check_configuration.test", "time_window_days", "7"),
+					resource.TestCheckResourceAttr("apollo_variant_check_configuration.test", "minimum_request_count", "1"),
+					resource.TestCheckResourceAttr("apollo_variant_check_configuration.test", "excluded_clients.#", "0"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "apollo_variant_check_configuration.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccVariantCheckConfigurationResourceConfig(30, `["internal-tools"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollo_variant_check_configuration.test", "time_window_days", "30"),
+					resource.TestCheckResourceAttr("apollo_variant_check_configuration.test", "time_window_seconds", "2592000"),
+					resource.TestCheckTypeSetElemAttr("apollo_variant_check_configuration.test", "excluded_clients.*", "internal-tools"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccVariantCheckConfigurationResourceConfig(days int, excludedClients string) string {
+	return fmt.Sprintf(`
+resource "apollo_graph" "test" {
+  org_id     = %[1]q
+  graph_name = "tf-acc-check-configuration-graph"
+}
+
+resource "apollo_variant_check_configuration" "test" {
+  graph_id         = apollo_graph.test.graph_id
+  time_window_days = %[2]d
+  excluded_clients = %[3]s
+}
+`, os.Getenv("APOLLO_ORG_ID"), days, excludedClients)
+}